@@ -268,7 +268,13 @@ func FToI[Int constraints.Integer, Flt constraints.Float](number Flt) (Int, erro
 // Shifts an integer left to specified shift count and detects whether an overflow
 // has occurred or not.
 //
-// Shift count is also checked for negativity.
+// Shift count is also checked for negativity. Shift count not less than the bit width
+// of the type is allowed and is not an error if the integer is zero, use the
+// [ShiftLeftStrict] function to prohibit it.
+//
+// For signed types, a shift that changes the sign of the integer, i.e. shifts a
+// significant bit into or beyond the sign bit, is considered an overflow, as is a
+// shift of a negative integer whose result does not fit into the type.
 //
 // In case of overflow or shift count is negative, an error is returned.
 func Shift[Type, CountType constraints.Integer](number Type, count CountType) (Type, error) {
@@ -0,0 +1,2 @@
+// Package with atomic integers that detect and avoid overflows.
+package concurrent
@@ -0,0 +1,8 @@
+package concurrent
+
+import "errors"
+
+var (
+	ErrInvalidBounds = errors.New("lower bound is greater than upper bound")
+	ErrOutOfBounds   = errors.New("value is out of bounds")
+)
@@ -0,0 +1,209 @@
+package concurrent
+
+import (
+	"sync/atomic"
+
+	"github.com/akramarenkov/safe"
+
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
+
+// Atomic signed integer that detects overflows.
+//
+// Value can be limited by a range narrower than the range of the type, see
+// [NewBoundedAtomicInt]. Exit beyond the range is treated as an overflow.
+//
+// The zero value is an unbounded atomic integer equal to zero.
+//
+// Must not be copied after first use.
+type AtomicInt[Type constraints.Signed] struct {
+	value atomic.Int64
+
+	bounded bool
+	lower   Type
+	upper   Type
+}
+
+// Creates an atomic signed integer with the range of the type.
+func NewAtomicInt[Type constraints.Signed](value Type) *AtomicInt[Type] {
+	atm := &AtomicInt[Type]{}
+
+	atm.value.Store(int64(value))
+
+	return atm
+}
+
+// Creates an atomic signed integer with the range limited by lower and upper bounds
+// inclusive.
+//
+// In case of lower bound is greater than upper bound or value is out of bounds, an
+// error is returned.
+func NewBoundedAtomicInt[Type constraints.Signed](
+	value Type,
+	lower Type,
+	upper Type,
+) (*AtomicInt[Type], error) {
+	if lower > upper {
+		return nil, ErrInvalidBounds
+	}
+
+	if value < lower || value > upper {
+		return nil, ErrOutOfBounds
+	}
+
+	atm := &AtomicInt[Type]{
+		bounded: true,
+		lower:   lower,
+		upper:   upper,
+	}
+
+	atm.value.Store(int64(value))
+
+	return atm, nil
+}
+
+// Returns lower and upper bounds of the value.
+func (atm *AtomicInt[Type]) Bounds() (Type, Type) {
+	if atm.bounded {
+		return atm.lower, atm.upper
+	}
+
+	return intspec.Range[Type]()
+}
+
+// Atomically loads the value.
+func (atm *AtomicInt[Type]) Load() Type {
+	return Type(atm.value.Load())
+}
+
+// Atomically stores the value.
+//
+// In case of value is out of bounds, an error is returned and the value is not
+// stored.
+func (atm *AtomicInt[Type]) Store(value Type) error {
+	if !atm.within(value) {
+		return ErrOutOfBounds
+	}
+
+	atm.value.Store(int64(value))
+
+	return nil
+}
+
+// Executes the compare-and-swap operation for the value.
+//
+// In case of new value is out of bounds, an error is returned and the value is not
+// swapped.
+func (atm *AtomicInt[Type]) CompareAndSwap(old, updated Type) (bool, error) {
+	if !atm.within(updated) {
+		return false, ErrOutOfBounds
+	}
+
+	return atm.value.CompareAndSwap(int64(old), int64(updated)), nil
+}
+
+// Atomically adds delta to the value and detects whether an overflow has occurred or
+// not.
+//
+// In case of overflow, an error is returned and the value remains unchanged. Otherwise
+// the new value is returned.
+func (atm *AtomicInt[Type]) Add(delta Type) (Type, error) {
+	return atm.update(delta, safe.Add[Type])
+}
+
+// Atomically subtracts delta from the value and detects whether an overflow has
+// occurred or not.
+//
+// In case of overflow, an error is returned and the value remains unchanged. Otherwise
+// the new value is returned.
+func (atm *AtomicInt[Type]) Sub(delta Type) (Type, error) {
+	return atm.update(delta, safe.Sub[Type])
+}
+
+// Atomically increments the value and detects whether an overflow has occurred or
+// not.
+//
+// In case of overflow, an error is returned and the value remains unchanged. Otherwise
+// the new value is returned.
+func (atm *AtomicInt[Type]) Inc() (Type, error) {
+	return atm.Add(1)
+}
+
+// Atomically decrements the value and detects whether an overflow has occurred or
+// not.
+//
+// In case of overflow, an error is returned and the value remains unchanged. Otherwise
+// the new value is returned.
+func (atm *AtomicInt[Type]) Dec() (Type, error) {
+	return atm.Sub(1)
+}
+
+// Atomically adds delta to the value with saturation: in case of overflow the value
+// becomes equal to the corresponding bound. Returns the new value.
+func (atm *AtomicInt[Type]) AddSat(delta Type) Type {
+	return atm.saturate(delta, delta > 0, safe.Add[Type])
+}
+
+// Atomically subtracts delta from the value with saturation: in case of overflow the
+// value becomes equal to the corresponding bound. Returns the new value.
+func (atm *AtomicInt[Type]) SubSat(delta Type) Type {
+	return atm.saturate(delta, delta < 0, safe.Sub[Type])
+}
+
+func (atm *AtomicInt[Type]) update(
+	delta Type,
+	operation func(Type, Type) (Type, error),
+) (Type, error) {
+	for {
+		current := atm.value.Load()
+
+		updated, err := operation(Type(current), delta)
+		if err != nil {
+			return 0, err
+		}
+
+		if !atm.within(updated) {
+			return 0, safe.ErrOverflow
+		}
+
+		if atm.value.CompareAndSwap(current, int64(updated)) {
+			return updated, nil
+		}
+	}
+}
+
+func (atm *AtomicInt[Type]) saturate(
+	delta Type,
+	increasing bool,
+	operation func(Type, Type) (Type, error),
+) Type {
+	lower, upper := atm.Bounds()
+
+	for {
+		current := atm.value.Load()
+
+		updated, err := operation(Type(current), delta)
+
+		switch {
+		case err != nil && increasing:
+			updated = upper
+		case err != nil:
+			updated = lower
+		default:
+			updated = min(max(updated, lower), upper)
+		}
+
+		if atm.value.CompareAndSwap(current, int64(updated)) {
+			return updated
+		}
+	}
+}
+
+func (atm *AtomicInt[Type]) within(value Type) bool {
+	if !atm.bounded {
+		return true
+	}
+
+	return value >= atm.lower && value <= atm.upper
+}
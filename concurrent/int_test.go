@@ -0,0 +1,257 @@
+package concurrent
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/akramarenkov/safe"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAtomicInt(t *testing.T) {
+	atm := NewAtomicInt[int8](math.MaxInt8 - 2)
+
+	value, err := atm.Inc()
+	require.NoError(t, err)
+	require.Equal(t, int8(math.MaxInt8-1), value)
+
+	value, err = atm.Add(1)
+	require.NoError(t, err)
+	require.Equal(t, int8(math.MaxInt8), value)
+
+	_, err = atm.Inc()
+	require.ErrorIs(t, err, safe.ErrOverflow)
+	require.Equal(t, int8(math.MaxInt8), atm.Load())
+
+	value, err = atm.Sub(math.MaxInt8)
+	require.NoError(t, err)
+	require.Equal(t, int8(0), value)
+
+	value, err = atm.Add(math.MinInt8)
+	require.NoError(t, err)
+	require.Equal(t, int8(math.MinInt8), value)
+
+	_, err = atm.Dec()
+	require.ErrorIs(t, err, safe.ErrOverflow)
+	require.Equal(t, int8(math.MinInt8), atm.Load())
+
+	_, err = atm.Sub(1)
+	require.ErrorIs(t, err, safe.ErrOverflow)
+	require.Equal(t, int8(math.MinInt8), atm.Load())
+
+	value, err = atm.Sub(-1)
+	require.NoError(t, err)
+	require.Equal(t, int8(math.MinInt8+1), value)
+}
+
+func TestAtomicIntZeroValue(t *testing.T) {
+	var atm AtomicInt[int16]
+
+	lower, upper := atm.Bounds()
+	require.Equal(t, int16(math.MinInt16), lower)
+	require.Equal(t, int16(math.MaxInt16), upper)
+
+	value, err := atm.Dec()
+	require.NoError(t, err)
+	require.Equal(t, int16(-1), value)
+}
+
+func TestAtomicIntBounded(t *testing.T) {
+	_, err := NewBoundedAtomicInt[int8](0, 1, -1)
+	require.ErrorIs(t, err, ErrInvalidBounds)
+
+	_, err = NewBoundedAtomicInt[int8](2, -1, 1)
+	require.ErrorIs(t, err, ErrOutOfBounds)
+
+	atm, err := NewBoundedAtomicInt[int8](0, -10, 10)
+	require.NoError(t, err)
+
+	lower, upper := atm.Bounds()
+	require.Equal(t, int8(-10), lower)
+	require.Equal(t, int8(10), upper)
+
+	value, err := atm.Add(10)
+	require.NoError(t, err)
+	require.Equal(t, int8(10), value)
+
+	_, err = atm.Inc()
+	require.ErrorIs(t, err, safe.ErrOverflow)
+	require.Equal(t, int8(10), atm.Load())
+
+	_, err = atm.Sub(21)
+	require.ErrorIs(t, err, safe.ErrOverflow)
+	require.Equal(t, int8(10), atm.Load())
+
+	require.ErrorIs(t, atm.Store(11), ErrOutOfBounds)
+	require.Equal(t, int8(10), atm.Load())
+
+	require.NoError(t, atm.Store(-10))
+	require.Equal(t, int8(-10), atm.Load())
+
+	swapped, err := atm.CompareAndSwap(-10, -11)
+	require.ErrorIs(t, err, ErrOutOfBounds)
+	require.False(t, swapped)
+
+	swapped, err = atm.CompareAndSwap(-9, 5)
+	require.NoError(t, err)
+	require.False(t, swapped)
+
+	swapped, err = atm.CompareAndSwap(-10, 5)
+	require.NoError(t, err)
+	require.True(t, swapped)
+	require.Equal(t, int8(5), atm.Load())
+}
+
+func TestAtomicIntSat(t *testing.T) {
+	atm := NewAtomicInt[int8](100)
+
+	require.Equal(t, int8(math.MaxInt8), atm.AddSat(100))
+	require.Equal(t, int8(math.MaxInt8), atm.AddSat(1))
+	require.Equal(t, int8(math.MaxInt8), atm.SubSat(-1))
+	require.Equal(t, int8(0), atm.SubSat(math.MaxInt8))
+	require.Equal(t, int8(math.MinInt8), atm.AddSat(math.MinInt8))
+	require.Equal(t, int8(math.MinInt8), atm.SubSat(1))
+	require.Equal(t, int8(math.MinInt8+5), atm.SubSat(-5))
+
+	bounded, err := NewBoundedAtomicInt[int8](0, -10, 10)
+	require.NoError(t, err)
+
+	require.Equal(t, int8(10), bounded.AddSat(11))
+	require.Equal(t, int8(-10), bounded.SubSat(100))
+	require.Equal(t, int8(10), bounded.AddSat(math.MaxInt8))
+	require.Equal(t, int8(-10), bounded.AddSat(math.MinInt8))
+}
+
+func TestAtomicIntConcurrent(t *testing.T) {
+	const (
+		goroutines = 8
+		increments = 1000
+	)
+
+	atm, err := NewBoundedAtomicInt[int32](0, 0, goroutines*increments/2)
+	require.NoError(t, err)
+
+	successful := atomic.Int64{}
+	overflowed := atomic.Int64{}
+
+	wg := sync.WaitGroup{}
+
+	for range goroutines {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range increments {
+				if _, err := atm.Inc(); err != nil {
+					overflowed.Add(1)
+					continue
+				}
+
+				successful.Add(1)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	require.Equal(t, int32(goroutines*increments/2), atm.Load())
+	require.Equal(t, int64(goroutines*increments/2), successful.Load())
+	require.Equal(t, int64(goroutines*increments/2), overflowed.Load())
+}
+
+func TestAtomicIntConcurrentSat(t *testing.T) {
+	const (
+		goroutines = 8
+		increments = 1000
+	)
+
+	atm := NewAtomicInt[int8](0)
+
+	wg := sync.WaitGroup{}
+
+	for range goroutines {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range increments {
+				atm.AddSat(1)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	require.Equal(t, int8(math.MaxInt8), atm.Load())
+}
+
+func BenchmarkAtomicIntReference(b *testing.B) {
+	atm := atomic.Int64{}
+
+	for range b.N {
+		atm.Add(1)
+	}
+
+	require.NotZero(b, atm.Load())
+}
+
+func BenchmarkAtomicIntAdd(b *testing.B) {
+	atm := NewAtomicInt[int64](0)
+
+	for range b.N {
+		_, _ = atm.Add(1)
+	}
+
+	require.NotZero(b, atm.Load())
+}
+
+func BenchmarkAtomicIntAddSat(b *testing.B) {
+	atm := NewAtomicInt[int64](0)
+
+	for range b.N {
+		atm.AddSat(1)
+	}
+
+	require.NotZero(b, atm.Load())
+}
+
+func BenchmarkRaceAtomicIntReference(b *testing.B) {
+	atm := atomic.Int64{}
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			atm.Add(1)
+		}
+	})
+
+	require.NotZero(b, atm.Load())
+}
+
+func BenchmarkRaceAtomicIntAdd(b *testing.B) {
+	atm := NewAtomicInt[int64](0)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = atm.Add(1)
+		}
+	})
+
+	require.NotZero(b, atm.Load())
+}
+
+func BenchmarkRaceAtomicIntAddSat(b *testing.B) {
+	atm := NewAtomicInt[int64](0)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			atm.AddSat(1)
+		}
+	})
+
+	require.NotZero(b, atm.Load())
+}
@@ -0,0 +1,209 @@
+package concurrent
+
+import (
+	"sync/atomic"
+
+	"github.com/akramarenkov/safe"
+
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
+
+// Atomic unsigned integer that detects overflows.
+//
+// Value can be limited by a range narrower than the range of the type, see
+// [NewBoundedAtomicUint]. Exit beyond the range is treated as an overflow.
+//
+// The zero value is an unbounded atomic unsigned integer equal to zero.
+//
+// Must not be copied after first use.
+type AtomicUint[Type constraints.Unsigned] struct {
+	value atomic.Uint64
+
+	bounded bool
+	lower   Type
+	upper   Type
+}
+
+// Creates an atomic unsigned integer with the range of the type.
+func NewAtomicUint[Type constraints.Unsigned](value Type) *AtomicUint[Type] {
+	atm := &AtomicUint[Type]{}
+
+	atm.value.Store(uint64(value))
+
+	return atm
+}
+
+// Creates an atomic unsigned integer with the range limited by lower and upper bounds
+// inclusive.
+//
+// In case of lower bound is greater than upper bound or value is out of bounds, an
+// error is returned.
+func NewBoundedAtomicUint[Type constraints.Unsigned](
+	value Type,
+	lower Type,
+	upper Type,
+) (*AtomicUint[Type], error) {
+	if lower > upper {
+		return nil, ErrInvalidBounds
+	}
+
+	if value < lower || value > upper {
+		return nil, ErrOutOfBounds
+	}
+
+	atm := &AtomicUint[Type]{
+		bounded: true,
+		lower:   lower,
+		upper:   upper,
+	}
+
+	atm.value.Store(uint64(value))
+
+	return atm, nil
+}
+
+// Returns lower and upper bounds of the value.
+func (atm *AtomicUint[Type]) Bounds() (Type, Type) {
+	if atm.bounded {
+		return atm.lower, atm.upper
+	}
+
+	return intspec.Range[Type]()
+}
+
+// Atomically loads the value.
+func (atm *AtomicUint[Type]) Load() Type {
+	return Type(atm.value.Load())
+}
+
+// Atomically stores the value.
+//
+// In case of value is out of bounds, an error is returned and the value is not
+// stored.
+func (atm *AtomicUint[Type]) Store(value Type) error {
+	if !atm.within(value) {
+		return ErrOutOfBounds
+	}
+
+	atm.value.Store(uint64(value))
+
+	return nil
+}
+
+// Executes the compare-and-swap operation for the value.
+//
+// In case of new value is out of bounds, an error is returned and the value is not
+// swapped.
+func (atm *AtomicUint[Type]) CompareAndSwap(old, updated Type) (bool, error) {
+	if !atm.within(updated) {
+		return false, ErrOutOfBounds
+	}
+
+	return atm.value.CompareAndSwap(uint64(old), uint64(updated)), nil
+}
+
+// Atomically adds delta to the value and detects whether an overflow has occurred or
+// not.
+//
+// In case of overflow, an error is returned and the value remains unchanged. Otherwise
+// the new value is returned.
+func (atm *AtomicUint[Type]) Add(delta Type) (Type, error) {
+	return atm.update(delta, safe.AddU[Type])
+}
+
+// Atomically subtracts delta from the value and detects whether an overflow has
+// occurred or not.
+//
+// In case of overflow, an error is returned and the value remains unchanged. Otherwise
+// the new value is returned.
+func (atm *AtomicUint[Type]) Sub(delta Type) (Type, error) {
+	return atm.update(delta, safe.SubU[Type])
+}
+
+// Atomically increments the value and detects whether an overflow has occurred or
+// not.
+//
+// In case of overflow, an error is returned and the value remains unchanged. Otherwise
+// the new value is returned.
+func (atm *AtomicUint[Type]) Inc() (Type, error) {
+	return atm.Add(1)
+}
+
+// Atomically decrements the value and detects whether an overflow has occurred or
+// not.
+//
+// In case of overflow, an error is returned and the value remains unchanged. Otherwise
+// the new value is returned.
+func (atm *AtomicUint[Type]) Dec() (Type, error) {
+	return atm.Sub(1)
+}
+
+// Atomically adds delta to the value with saturation: in case of overflow the value
+// becomes equal to the corresponding bound. Returns the new value.
+func (atm *AtomicUint[Type]) AddSat(delta Type) Type {
+	return atm.saturate(delta, true, safe.AddU[Type])
+}
+
+// Atomically subtracts delta from the value with saturation: in case of overflow the
+// value becomes equal to the corresponding bound. Returns the new value.
+func (atm *AtomicUint[Type]) SubSat(delta Type) Type {
+	return atm.saturate(delta, false, safe.SubU[Type])
+}
+
+func (atm *AtomicUint[Type]) update(
+	delta Type,
+	operation func(Type, Type) (Type, error),
+) (Type, error) {
+	for {
+		current := atm.value.Load()
+
+		updated, err := operation(Type(current), delta)
+		if err != nil {
+			return 0, err
+		}
+
+		if !atm.within(updated) {
+			return 0, safe.ErrOverflow
+		}
+
+		if atm.value.CompareAndSwap(current, uint64(updated)) {
+			return updated, nil
+		}
+	}
+}
+
+func (atm *AtomicUint[Type]) saturate(
+	delta Type,
+	increasing bool,
+	operation func(Type, Type) (Type, error),
+) Type {
+	lower, upper := atm.Bounds()
+
+	for {
+		current := atm.value.Load()
+
+		updated, err := operation(Type(current), delta)
+
+		switch {
+		case err != nil && increasing:
+			updated = upper
+		case err != nil:
+			updated = lower
+		default:
+			updated = min(max(updated, lower), upper)
+		}
+
+		if atm.value.CompareAndSwap(current, uint64(updated)) {
+			return updated
+		}
+	}
+}
+
+func (atm *AtomicUint[Type]) within(value Type) bool {
+	if !atm.bounded {
+		return true
+	}
+
+	return value >= atm.lower && value <= atm.upper
+}
@@ -0,0 +1,235 @@
+package concurrent
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/akramarenkov/safe"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAtomicUint(t *testing.T) {
+	atm := NewAtomicUint[uint8](math.MaxUint8 - 2)
+
+	value, err := atm.Inc()
+	require.NoError(t, err)
+	require.Equal(t, uint8(math.MaxUint8-1), value)
+
+	value, err = atm.Add(1)
+	require.NoError(t, err)
+	require.Equal(t, uint8(math.MaxUint8), value)
+
+	_, err = atm.Inc()
+	require.ErrorIs(t, err, safe.ErrOverflow)
+	require.Equal(t, uint8(math.MaxUint8), atm.Load())
+
+	value, err = atm.Sub(math.MaxUint8 - 1)
+	require.NoError(t, err)
+	require.Equal(t, uint8(1), value)
+
+	value, err = atm.Dec()
+	require.NoError(t, err)
+	require.Equal(t, uint8(0), value)
+
+	_, err = atm.Dec()
+	require.ErrorIs(t, err, safe.ErrOverflow)
+	require.Equal(t, uint8(0), atm.Load())
+}
+
+func TestAtomicUintZeroValue(t *testing.T) {
+	var atm AtomicUint[uint16]
+
+	lower, upper := atm.Bounds()
+	require.Equal(t, uint16(0), lower)
+	require.Equal(t, uint16(math.MaxUint16), upper)
+
+	value, err := atm.Inc()
+	require.NoError(t, err)
+	require.Equal(t, uint16(1), value)
+}
+
+func TestAtomicUintBounded(t *testing.T) {
+	_, err := NewBoundedAtomicUint[uint8](5, 10, 1)
+	require.ErrorIs(t, err, ErrInvalidBounds)
+
+	_, err = NewBoundedAtomicUint[uint8](0, 1, 10)
+	require.ErrorIs(t, err, ErrOutOfBounds)
+
+	atm, err := NewBoundedAtomicUint[uint8](5, 1, 10)
+	require.NoError(t, err)
+
+	lower, upper := atm.Bounds()
+	require.Equal(t, uint8(1), lower)
+	require.Equal(t, uint8(10), upper)
+
+	_, err = atm.Sub(5)
+	require.ErrorIs(t, err, safe.ErrOverflow)
+	require.Equal(t, uint8(5), atm.Load())
+
+	_, err = atm.Add(6)
+	require.ErrorIs(t, err, safe.ErrOverflow)
+	require.Equal(t, uint8(5), atm.Load())
+
+	require.ErrorIs(t, atm.Store(0), ErrOutOfBounds)
+	require.Equal(t, uint8(5), atm.Load())
+
+	swapped, err := atm.CompareAndSwap(5, 11)
+	require.ErrorIs(t, err, ErrOutOfBounds)
+	require.False(t, swapped)
+
+	swapped, err = atm.CompareAndSwap(5, 10)
+	require.NoError(t, err)
+	require.True(t, swapped)
+	require.Equal(t, uint8(10), atm.Load())
+}
+
+func TestAtomicUintSat(t *testing.T) {
+	atm := NewAtomicUint[uint8](200)
+
+	require.Equal(t, uint8(math.MaxUint8), atm.AddSat(100))
+	require.Equal(t, uint8(math.MaxUint8), atm.AddSat(1))
+	require.Equal(t, uint8(55), atm.SubSat(200))
+	require.Equal(t, uint8(0), atm.SubSat(56))
+	require.Equal(t, uint8(0), atm.SubSat(1))
+
+	bounded, err := NewBoundedAtomicUint[uint8](5, 1, 10)
+	require.NoError(t, err)
+
+	require.Equal(t, uint8(10), bounded.AddSat(6))
+	require.Equal(t, uint8(1), bounded.SubSat(10))
+	require.Equal(t, uint8(10), bounded.AddSat(math.MaxUint8))
+}
+
+func TestAtomicUintConcurrent(t *testing.T) {
+	const (
+		goroutines = 8
+		increments = 1000
+	)
+
+	atm, err := NewBoundedAtomicUint[uint32](0, 0, goroutines*increments/2)
+	require.NoError(t, err)
+
+	successful := atomic.Int64{}
+	overflowed := atomic.Int64{}
+
+	wg := sync.WaitGroup{}
+
+	for range goroutines {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range increments {
+				if _, err := atm.Inc(); err != nil {
+					overflowed.Add(1)
+					continue
+				}
+
+				successful.Add(1)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	require.Equal(t, uint32(goroutines*increments/2), atm.Load())
+	require.Equal(t, int64(goroutines*increments/2), successful.Load())
+	require.Equal(t, int64(goroutines*increments/2), overflowed.Load())
+}
+
+func TestAtomicUintConcurrentSat(t *testing.T) {
+	const (
+		goroutines = 8
+		decrements = 1000
+	)
+
+	atm := NewAtomicUint[uint8](math.MaxUint8)
+
+	wg := sync.WaitGroup{}
+
+	for range goroutines {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range decrements {
+				atm.SubSat(1)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	require.Equal(t, uint8(0), atm.Load())
+}
+
+func BenchmarkAtomicUintReference(b *testing.B) {
+	atm := atomic.Uint64{}
+
+	for range b.N {
+		atm.Add(1)
+	}
+
+	require.NotZero(b, atm.Load())
+}
+
+func BenchmarkAtomicUintAdd(b *testing.B) {
+	atm := NewAtomicUint[uint64](0)
+
+	for range b.N {
+		_, _ = atm.Add(1)
+	}
+
+	require.NotZero(b, atm.Load())
+}
+
+func BenchmarkAtomicUintAddSat(b *testing.B) {
+	atm := NewAtomicUint[uint64](0)
+
+	for range b.N {
+		atm.AddSat(1)
+	}
+
+	require.NotZero(b, atm.Load())
+}
+
+func BenchmarkRaceAtomicUintReference(b *testing.B) {
+	atm := atomic.Uint64{}
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			atm.Add(1)
+		}
+	})
+
+	require.NotZero(b, atm.Load())
+}
+
+func BenchmarkRaceAtomicUintAdd(b *testing.B) {
+	atm := NewAtomicUint[uint64](0)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = atm.Add(1)
+		}
+	})
+
+	require.NotZero(b, atm.Load())
+}
+
+func BenchmarkRaceAtomicUintAddSat(b *testing.B) {
+	atm := NewAtomicUint[uint64](0)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			atm.AddSat(1)
+		}
+	})
+
+	require.NotZero(b, atm.Load())
+}
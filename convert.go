@@ -0,0 +1,77 @@
+package safe
+
+import (
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Detects whether an integer of one type can be converted to an integer of another
+// type without overflow.
+func CanConvert[TypeTo, TypeFrom constraints.Integer](number TypeFrom) bool {
+	_, err := IToI[TypeTo](number)
+	return err == nil
+}
+
+// An iterator that converts integers of a sequence to integers of another type.
+//
+// In addition to the converted integer, the conversion error is returned. In case of
+// overflow, the converted integer is zero and iteration continues, so the caller
+// decides whether to stop it or not.
+func ConvertSeq[TypeTo, TypeFrom constraints.Integer](seq iter.Seq[TypeFrom]) iter.Seq2[TypeTo, error] {
+	iterator := func(yield func(TypeTo, error) bool) {
+		for number := range seq {
+			if !yield(IToI[TypeTo](number)) {
+				return
+			}
+		}
+	}
+
+	return iterator
+}
+
+// Converts integers of a slice to integers of another type and returns them in a new
+// slice. Unlike [ConvertSlice], the conversion is all-or-nothing: the new slice is
+// returned only if all integers have been converted.
+//
+// In case of overflow, the index of the first integer for which it occurred and an
+// error are returned.
+func ConvertSliceNew[TypeTo, TypeFrom constraints.Integer](src []TypeFrom) ([]TypeTo, int, error) {
+	dst := make([]TypeTo, len(src))
+
+	if index, err := ConvertSlice(dst, src); err != nil {
+		return nil, index, err
+	}
+
+	return dst, 0, nil
+}
+
+// Converts values of a map to integers of another type and returns them in a new map
+// with the same keys. The conversion is all-or-nothing: the new map is returned only
+// if all values have been converted.
+//
+// In case of overflow, keys of all values for which it occurred and an error are
+// returned. The order of keys is unspecified, as the iteration order over a map.
+func ConvertMap[TypeTo constraints.Integer, Key comparable, TypeFrom constraints.Integer](
+	src map[Key]TypeFrom,
+) (map[Key]TypeTo, []Key, error) {
+	dst := make(map[Key]TypeTo, len(src))
+
+	var failed []Key
+
+	for key, value := range src {
+		converted, err := IToI[TypeTo](value)
+		if err != nil {
+			failed = append(failed, key)
+			continue
+		}
+
+		dst[key] = converted
+	}
+
+	if len(failed) != 0 {
+		return nil, failed, ErrOverflow
+	}
+
+	return dst, nil, nil
+}
@@ -0,0 +1,131 @@
+package safe
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanConvert(t *testing.T) {
+	for number := range Iter[int8](math.MinInt8, math.MaxInt8) {
+		_, err := IToI[uint8](number)
+		require.Equal(t, err == nil, CanConvert[uint8](number))
+	}
+
+	for number := range Iter[uint8](0, math.MaxUint8) {
+		_, err := IToI[int8](number)
+		require.Equal(t, err == nil, CanConvert[int8](number))
+	}
+
+	require.True(t, CanConvert[int32](int64(math.MaxInt32)))
+	require.False(t, CanConvert[int32](int64(math.MaxInt32)+1))
+	require.False(t, CanConvert[uint16](int64(-1)))
+}
+
+func TestConvertSeq(t *testing.T) {
+	src := []int16{-129, -128, -1, 0, 1, 127, 128}
+
+	converted := []int8(nil)
+	failed := []int(nil)
+
+	id := 0
+
+	for number, err := range ConvertSeq[int8](slices.Values(src)) {
+		if err != nil {
+			require.ErrorIs(t, err, ErrOverflow)
+			require.Zero(t, number)
+
+			failed = append(failed, id)
+		}
+
+		converted = append(converted, number)
+		id++
+	}
+
+	require.Equal(t, []int8{0, -128, -1, 0, 1, 127, 0}, converted)
+	require.Equal(t, []int{0, 6}, failed)
+}
+
+func TestConvertSeqPart(t *testing.T) {
+	count := 0
+
+	for _, err := range ConvertSeq[uint8](slices.Values([]int{1, -1, 2})) {
+		if err != nil {
+			break
+		}
+
+		count++
+	}
+
+	require.Equal(t, 1, count)
+}
+
+func TestConvertSliceNew(t *testing.T) {
+	dst, index, err := ConvertSliceNew[int8]([]int16{-128, 0, 127})
+	require.NoError(t, err)
+	require.Equal(t, []int8{-128, 0, 127}, dst)
+	require.Zero(t, index)
+
+	dst, index, err = ConvertSliceNew[int8]([]int16{-128, 0, 128, -129})
+	require.ErrorIs(t, err, ErrOverflow)
+	require.Nil(t, dst)
+	require.Equal(t, 2, index)
+
+	dst, index, err = ConvertSliceNew[int8]([]int16(nil))
+	require.NoError(t, err)
+	require.Empty(t, dst)
+	require.Zero(t, index)
+}
+
+func TestConvertMap(t *testing.T) {
+	src := map[string]int64{"first": math.MaxUint16, "second": 0}
+
+	dst, failed, err := ConvertMap[uint16](src)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint16{"first": math.MaxUint16, "second": 0}, dst)
+	require.Empty(t, failed)
+
+	src["third"] = -1
+
+	dst, failed, err = ConvertMap[uint16](src)
+	require.ErrorIs(t, err, ErrOverflow)
+	require.Nil(t, dst)
+	require.Equal(t, []string{"third"}, failed)
+
+	src["fourth"] = math.MaxUint16 + 1
+
+	// Failed keys are the same regardless of the iteration order over the map
+	for range 10 {
+		dst, failed, err = ConvertMap[uint16](src)
+		require.ErrorIs(t, err, ErrOverflow)
+		require.Nil(t, dst)
+		require.ElementsMatch(t, []string{"third", "fourth"}, failed)
+	}
+
+	dst, failed, err = ConvertMap[uint16](map[string]int64(nil))
+	require.NoError(t, err)
+	require.Empty(t, dst)
+	require.Empty(t, failed)
+}
+
+func BenchmarkConvertSeq(b *testing.B) {
+	src := make([]int64, b.N)
+
+	for id := range src {
+		src[id] = int64(id%math.MaxInt32) + 1
+	}
+
+	result := int32(0)
+
+	for number, err := range ConvertSeq[int32](slices.Values(src)) {
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		result = number
+	}
+
+	require.NotZero(b, result)
+}
@@ -0,0 +1,79 @@
+package safe
+
+import (
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
+
+// Options of calculating the delta of monotonic counter readings by [CounterDelta].
+//
+// The zero value is a valid options for counters that occupy the entire bit size of
+// their type.
+type CounterOpts struct {
+	// Bit size of the counter. Used for counters stored in a type wider than the
+	// counter itself, for example, for 32-bit hardware counters stored in uint64.
+	// If zero, the bit size of the counter type is used
+	BitSize int
+
+	// Maximum plausible delta between two consecutive counter readings. A decrease
+	// in the counter value that would correspond to a larger delta in the case of a
+	// wrap is considered a reset of the counter. If zero, half of the counter range
+	// minus one is used, as in serial number arithmetic
+	MaxDelta uint64
+}
+
+// Calculates the delta between two consecutive readings of a monotonic counter that
+// wraps around upon reaching its maximum value, for example, uint32 packet counters
+// or TCP sequence numbers.
+//
+// A decrease in the counter value is considered a single wrap if the delta obtained
+// by rollover does not exceed the maximum plausible delta specified in the options.
+// Otherwise, the decrease is considered a reset of the counter, in this case zero
+// delta and an error are returned. If the counter starts from zero after a reset, the
+// caller should use the current value as the delta since the reset.
+//
+// In addition to the delta, it is returned whether a wrap has occurred.
+//
+// In case of invalid bit size, readings exceeding the bit size of the counter or a
+// reset of the counter, an error is returned.
+func CounterDelta[Type constraints.Unsigned](
+	previous Type,
+	current Type,
+	opts CounterOpts,
+) (uint64, bool, error) {
+	bitSize := intspec.BitSize[Type]()
+
+	if opts.BitSize < 0 || opts.BitSize > bitSize {
+		return 0, false, ErrBitSizeInvalid
+	}
+
+	if opts.BitSize != 0 {
+		bitSize = opts.BitSize
+	}
+
+	maximum := uint64(intspec.MaxUint64) >> (intspec.BitSize64 - bitSize)
+
+	if uint64(previous) > maximum || uint64(current) > maximum {
+		return 0, false, ErrOverflow
+	}
+
+	if delta, err := SubU(current, previous); err == nil {
+		return uint64(delta), false, nil
+	}
+
+	maxDelta := opts.MaxDelta
+
+	if maxDelta == 0 {
+		maxDelta = maximum >> 1
+	}
+
+	// Distance is not less than one and not greater than the maximum value of the
+	// counter, so the delta obtained by rollover fits into uint64
+	delta := maximum - Dist(previous, current) + 1
+
+	if delta > maxDelta {
+		return 0, false, ErrCounterReset
+	}
+
+	return delta, true, nil
+}
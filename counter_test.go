@@ -0,0 +1,117 @@
+package safe
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounterDelta(t *testing.T) {
+	for _, maxDelta := range []uint64{0, 1, 100, 200, math.MaxUint8, math.MaxUint64} {
+		opts := CounterOpts{
+			MaxDelta: maxDelta,
+		}
+
+		reference := maxDelta
+
+		if reference == 0 {
+			reference = math.MaxInt8
+		}
+
+		for previous := range Iter[uint8](0, math.MaxUint8) {
+			for current := range Iter[uint8](0, math.MaxUint8) {
+				delta, wrapped, err := CounterDelta(previous, current, opts)
+
+				if current >= previous {
+					require.NoError(t, err)
+					require.False(t, wrapped)
+					require.Equal(t, uint64(current-previous), delta)
+
+					continue
+				}
+
+				expected := uint64(math.MaxUint8+1) - uint64(previous) + uint64(current)
+
+				if expected > reference {
+					require.ErrorIs(t, err, ErrCounterReset, "previous: %v, current: %v", previous, current)
+					require.False(t, wrapped)
+					require.Zero(t, delta)
+
+					continue
+				}
+
+				require.NoError(t, err, "previous: %v, current: %v", previous, current)
+				require.True(t, wrapped, "previous: %v, current: %v", previous, current)
+				require.Equal(t, expected, delta, "previous: %v, current: %v", previous, current)
+			}
+		}
+	}
+}
+
+func TestCounterDeltaBitSize(t *testing.T) {
+	opts := CounterOpts{
+		BitSize: 32,
+	}
+
+	delta, wrapped, err := CounterDelta[uint64](math.MaxUint32-9, 10, opts)
+	require.NoError(t, err)
+	require.True(t, wrapped)
+	require.Equal(t, uint64(20), delta)
+
+	delta, wrapped, err = CounterDelta[uint64](10, math.MaxUint32, opts)
+	require.NoError(t, err)
+	require.False(t, wrapped)
+	require.Equal(t, uint64(math.MaxUint32-10), delta)
+
+	_, _, err = CounterDelta[uint64](math.MaxUint32/2, 10, opts)
+	require.ErrorIs(t, err, ErrCounterReset)
+
+	_, _, err = CounterDelta[uint64](math.MaxUint32+1, 10, opts)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	_, _, err = CounterDelta[uint64](10, math.MaxUint32+1, opts)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	_, _, err = CounterDelta[uint32](1, 0, CounterOpts{BitSize: 33})
+	require.ErrorIs(t, err, ErrBitSizeInvalid)
+
+	_, _, err = CounterDelta[uint32](1, 0, CounterOpts{BitSize: -1})
+	require.ErrorIs(t, err, ErrBitSizeInvalid)
+
+	delta, wrapped, err = CounterDelta[uint8](1, 0, CounterOpts{BitSize: 1, MaxDelta: 1})
+	require.NoError(t, err)
+	require.True(t, wrapped)
+	require.Equal(t, uint64(1), delta)
+}
+
+func TestCounterDeltaMax(t *testing.T) {
+	delta, wrapped, err := CounterDelta[uint64](math.MaxUint64, 0, CounterOpts{})
+	require.NoError(t, err)
+	require.True(t, wrapped)
+	require.Equal(t, uint64(1), delta)
+
+	delta, wrapped, err = CounterDelta[uint64](
+		1,
+		0,
+		CounterOpts{MaxDelta: math.MaxUint64},
+	)
+	require.NoError(t, err)
+	require.True(t, wrapped)
+	require.Equal(t, uint64(math.MaxUint64), delta)
+
+	delta, wrapped, err = CounterDelta[uint64](0, math.MaxUint64, CounterOpts{})
+	require.NoError(t, err)
+	require.False(t, wrapped)
+	require.Equal(t, uint64(math.MaxUint64), delta)
+}
+
+func BenchmarkCounterDelta(b *testing.B) {
+	delta := uint64(0)
+
+	for range b.N {
+		delta, _, _ = CounterDelta[uint32](math.MaxUint32, 1, CounterOpts{})
+	}
+
+	require.NotZero(b, delta)
+}
@@ -0,0 +1,131 @@
+package safe
+
+import (
+	"github.com/akramarenkov/safe/internal/wide"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Calculates the dot product of two vectors, i.e. the sum of products of their
+// corresponding integers, and detects whether an overflow has occurred or not.
+//
+// Products are summed up in a double-width accumulator, so an error is returned only
+// if the exact final result does not fit into the type, regardless of the order of
+// integers. Dot product of empty vectors is zero.
+//
+// In case of overflow or different lengths of vectors, an error is returned.
+func Dot[Type constraints.Integer](first, second []Type) (Type, error) {
+	return MulAddM(first, second, 0)
+}
+
+// Calculates the sum of products of corresponding integers of two vectors plus an
+// addend, i.e. Σ first[i]*second[i] + addend, and detects whether an overflow has
+// occurred or not.
+//
+// Products are summed up in a double-width accumulator, so an error is returned only
+// if the exact final result does not fit into the type, regardless of the order of
+// integers.
+//
+// In case of overflow or different lengths of vectors, an error is returned.
+func MulAddM[Type constraints.Integer](first, second []Type, addend Type) (Type, error) {
+	if len(first) != len(second) {
+		return 0, ErrLengthMismatch
+	}
+
+	sum := toWide(addend)
+
+	// Sum of products of 64-bit integers may go beyond 128 bits when partial sums
+	// are large, so the number of wraps of the accumulator is also counted. Exact
+	// sum is equal to the accumulator plus wraps multiplied by 2^128
+	wraps := 0
+
+	for id, factor := range first {
+		product, ok := wide.Mul((factor < 0) != (second[id] < 0), Abs(factor), Abs(second[id]))
+		if !ok {
+			// Product of signed 64-bit integers does not exceed 2^126 in absolute
+			// value, so only the product of unsigned integers can overflow, and
+			// the sum of non-negative products does not fit into the type
+			return 0, ErrOverflow
+		}
+
+		interim, wrap := sum.AddWrap(product)
+
+		sum = interim
+		wraps += wrap
+	}
+
+	if wraps != 0 {
+		return 0, ErrOverflow
+	}
+
+	return fromWide[Type](sum)
+}
+
+// Evaluates the polynomial with the specified coefficients at the point using
+// Horner's rule and detects whether an overflow has occurred or not. Coefficients are
+// specified in ascending order of powers, i.e. coefficients[i] is the coefficient of
+// point^i. Polynomial without coefficients is equal to zero.
+//
+// Each step of the rule is calculated in a double-width accumulator, and for points
+// with an absolute value not less than two an overflow at any step means an overflow
+// of the final result, so an error is returned only if the exact final result does
+// not fit into the type.
+//
+// In case of overflow, an error is returned.
+func Horner[Type constraints.Integer](coefficients []Type, point Type) (Type, error) {
+	switch {
+	case len(coefficients) == 0:
+		return 0, nil
+	case point == 0:
+		return coefficients[0], nil
+	case point == 1:
+		return hornerUnit(coefficients, false)
+	case point < 0 && Abs(point) == 1:
+		return hornerUnit(coefficients, true)
+	}
+
+	value := coefficients[len(coefficients)-1]
+
+	for id := len(coefficients) - 2; id >= 0; id-- {
+		// Product or sum that does not fit into 128 bits does not fit into the type
+		// as well
+		product, ok := wide.Mul((value < 0) != (point < 0), Abs(value), Abs(point))
+		if !ok {
+			return 0, ErrOverflow
+		}
+
+		sum, ok := product.Add(toWide(coefficients[id]))
+		if !ok {
+			return 0, ErrOverflow
+		}
+
+		interim, err := fromWide[Type](sum)
+		if err != nil {
+			return 0, err
+		}
+
+		value = interim
+	}
+
+	return value, nil
+}
+
+// Evaluates the polynomial at the point equal to one or minus one, i.e. calculates
+// the sum or alternating sum of coefficients.
+func hornerUnit[Type constraints.Integer](coefficients []Type, alternating bool) (Type, error) {
+	sum := wide.Int{}
+
+	for id, coefficient := range coefficients {
+		term := toWide(coefficient)
+
+		if alternating && id%2 != 0 {
+			term = term.Neg()
+		}
+
+		// Number of coefficients in a slice is limited by the amount of memory and
+		// is much less than 2^63, so the accumulator does not overflow
+		sum, _ = sum.Add(term)
+	}
+
+	return fromWide[Type](sum)
+}
@@ -0,0 +1,173 @@
+package safe
+
+import (
+	"math"
+	"testing"
+
+	"github.com/akramarenkov/intspec"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+func TestDot(t *testing.T) {
+	testDot(t, []int8{math.MinInt8, math.MinInt8 + 1, -11, -2, -1, 0, 1, 2, 11, math.MaxInt8 - 1, math.MaxInt8})
+	testDot(t, []uint8{0, 1, 2, 11, 100, math.MaxUint8 - 1, math.MaxUint8})
+}
+
+func testDot[Type constraints.Integer](t *testing.T, dataset []Type) {
+	for length := range 3 {
+		vectors := combinations(dataset, length)
+
+		for _, first := range vectors {
+			for _, second := range vectors {
+				for _, addend := range dataset {
+					reference := int64(addend)
+
+					for id := range first {
+						reference += int64(first[id]) * int64(second[id])
+					}
+
+					expected, fits := referenceFits[Type](reference)
+
+					actual, err := MulAddM(first, second, addend)
+					require.Equal(t, fits, err == nil, "first: %v, second: %v, addend: %v", first, second, addend)
+					require.Equal(t, expected, actual, "first: %v, second: %v, addend: %v", first, second, addend)
+				}
+
+				reference := int64(0)
+
+				for id := range first {
+					reference += int64(first[id]) * int64(second[id])
+				}
+
+				expected, fits := referenceFits[Type](reference)
+
+				actual, err := Dot(first, second)
+				require.Equal(t, fits, err == nil, "first: %v, second: %v", first, second)
+				require.Equal(t, expected, actual, "first: %v, second: %v", first, second)
+			}
+		}
+	}
+}
+
+func TestDotMax(t *testing.T) {
+	// Partial sums exceed 2^127
+	dot, err := Dot(
+		[]int64{math.MinInt64, math.MinInt64, math.MinInt64, math.MinInt64, math.MinInt64, math.MinInt64},
+		[]int64{math.MinInt64, math.MinInt64, math.MaxInt64, math.MaxInt64, 1, 1},
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), dot)
+
+	dot, err = MulAddM(
+		[]int64{math.MinInt64, math.MinInt64, math.MaxInt64, math.MaxInt64, math.MinInt64},
+		[]int64{math.MinInt64, math.MinInt64, math.MinInt64, math.MinInt64, 1},
+		math.MinInt64,
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), dot)
+
+	_, err = Dot(
+		[]int64{math.MinInt64, math.MinInt64, math.MinInt64},
+		[]int64{math.MinInt64, math.MinInt64, math.MaxInt64},
+	)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	dotU, err := Dot([]uint64{1 << 32, 1}, []uint64{1<<32 - 1, 1<<32 - 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), dotU)
+
+	_, err = Dot([]uint64{math.MaxUint64}, []uint64{math.MaxUint64})
+	require.ErrorIs(t, err, ErrOverflow)
+
+	_, err = Dot([]int8{1, 2}, []int8{1})
+	require.ErrorIs(t, err, ErrLengthMismatch)
+
+	dot, err = Dot([]int64{}, []int64{})
+	require.NoError(t, err)
+	require.Zero(t, dot)
+}
+
+func TestHorner(t *testing.T) {
+	testHorner(t, []int8{math.MinInt8, math.MinInt8 + 1, -3, -1, 0, 1, 2, 7, math.MaxInt8 - 1, math.MaxInt8})
+	testHorner(t, []uint8{0, 1, 2, 7, 100, math.MaxUint8 - 1, math.MaxUint8})
+}
+
+func testHorner[Type constraints.Integer](t *testing.T, dataset []Type) {
+	for length := range 4 {
+		for _, coefficients := range combinations(dataset, length) {
+			for point := range Iter(intspec.Range[Type]()) {
+				reference := int64(0)
+
+				// Powers and terms do not overflow int64 for 8-bit types and up to
+				// three coefficients
+				for id := len(coefficients) - 1; id >= 0; id-- {
+					reference = reference*int64(point) + int64(coefficients[id])
+				}
+
+				expected, fits := referenceFits[Type](reference)
+
+				actual, err := Horner(coefficients, point)
+				require.Equal(
+					t,
+					fits,
+					err == nil,
+					"coefficients: %v, point: %v, error: %v", coefficients, point, err,
+				)
+				require.Equal(t, expected, actual, "coefficients: %v, point: %v", coefficients, point)
+			}
+		}
+	}
+}
+
+func TestHornerMax(t *testing.T) {
+	value, err := Horner([]int64{math.MinInt64, math.MaxInt64, math.MaxInt64, math.MinInt64}, -1)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), value)
+
+	value, err = Horner([]int64{math.MinInt64, 1, math.MaxInt64, 1}, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), value)
+
+	// Product at the last step overflows, but the sum fits
+	value, err = Horner([]int64{math.MinInt64, 1 << 62}, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), value)
+
+	value, err = Horner([]int64{-1, 1 << 62}, 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MaxInt64), value)
+
+	_, err = Horner([]int64{0, 1 << 62}, 2)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	valueU, err := Horner([]uint64{1, 0, 1}, 1<<32-1)
+	require.NoError(t, err)
+	require.Equal(t, uint64((1<<32-1)*(1<<32-1)+1), valueU)
+
+	_, err = Horner([]uint64{0, math.MaxUint64}, math.MaxUint64)
+	require.ErrorIs(t, err, ErrOverflow)
+}
+
+func BenchmarkDot(b *testing.B) {
+	first := []int64{math.MaxInt64, math.MinInt64, 3, -5, 7, 11, -13, 17}
+	second := []int64{math.MaxInt64, math.MaxInt64 - 1, 5, 7, -11, 13, 17, -19}
+	dot := int64(0)
+
+	for range b.N {
+		dot, _ = Dot(first, second)
+	}
+
+	require.NotZero(b, dot)
+}
+
+func BenchmarkHorner(b *testing.B) {
+	coefficients := []int64{1, -2, 3, -4, 5, -6, 7, -8}
+	value := int64(0)
+
+	for range b.N {
+		value, _ = Horner(coefficients, 7)
+	}
+
+	require.NotZero(b, value)
+}
@@ -1,14 +1,32 @@
 package safe
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
-	ErrDivisionByZero   = errors.New("division by zero")
-	ErrMissingArguments = errors.New("missing arguments")
-	ErrNaN              = errors.New("number is NaN")
-	ErrNegativeShift    = errors.New("shift count is negative")
-	ErrOverflow         = errors.New("integer overflow")
-	ErrPrecisionLoss    = errors.New("loss of precision")
-	ErrStepNegative     = errors.New("iterator step is negative")
-	ErrStepZero         = errors.New("iterator step is zero")
+	ErrAlignmentInvalid  = errors.New("alignment is not a power of two")
+	ErrBitSizeInvalid    = errors.New("bit size is invalid")
+	ErrCounterReset      = errors.New("counter has been reset")
+	ErrDivisionByZero    = errors.New("division by zero")
+	ErrIndexOutOfRange   = errors.New("index out of range")
+	ErrInfinity          = errors.New("number is infinite")
+	ErrLargeShift        = errors.New("shift count is not less than the bit width")
+	ErrLengthMismatch    = errors.New("lengths of slices are different")
+	ErrMissingArguments  = errors.New("missing arguments")
+	ErrNaN               = errors.New("number is NaN")
+	ErrNegativeShift     = errors.New("shift count is negative")
+	ErrNumberNotPositive = errors.New("number is not positive")
+	ErrOverflow          = errors.New("integer overflow")
+	ErrOverflowMax       = fmt.Errorf("%w beyond the maximum value", ErrOverflow)
+	ErrOverflowMin       = fmt.Errorf("%w beyond the minimum value", ErrOverflow)
+	ErrPercentileRange   = errors.New("percentile is out of range")
+	ErrPrecisionLoss     = errors.New("loss of precision")
+	ErrRangeReversed     = errors.New("range begin is greater than end")
+	ErrSerialAddend      = errors.New("serial number addend exceeds half of the range")
+	ErrSerialUndefined   = errors.New("serial numbers comparison is undefined")
+	ErrStepNegative      = errors.New("iterator step is negative")
+	ErrStepZero          = errors.New("iterator step is zero")
+	ErrSyntaxInvalid     = errors.New("syntax is invalid")
 )
@@ -0,0 +1,260 @@
+package safe
+
+import (
+	"iter"
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+// A range iterator over floating point values from begin to end inclusive (if the
+// begin-end range is a multiple of the step) with the specified step.
+//
+// Each value is calculated from its integer index as begin+index*step rather than by
+// accumulating the step, so errors do not accumulate and the end value is neither
+// skipped nor duplicated due to rounding. The number of values is exactly equal to
+// the number of such values that do not go beyond the end, see [FloatStepSize].
+//
+// If begin is greater than end, the return value will be decremented, otherwise it
+// will be incremented.
+//
+// In addition to the main value, its index in the begin-end sequence is returned.
+//
+// Indices must be exactly representable by the floating point type, so the number of
+// values must not exceed 2^24 for float32 and 2^53 for float64. Also the step must not
+// be lost when added to the values of the range, otherwise the values would repeat.
+//
+// In case of NaN or infinite arguments, zero or negative step, too many values or too
+// small step, an error is returned.
+func FloatStep[Flt constraints.Float](begin, end, step Flt) (iter.Seq2[uint64, Flt], error) {
+	last, err := floatStepLast(begin, end, step)
+	if err != nil {
+		return nil, err
+	}
+
+	iterator := func(yield func(uint64, Flt) bool) {
+		for id := uint64(0); id <= last; id++ {
+			if !yield(id, floatStepPoint(begin, end, step, id)) {
+				return
+			}
+		}
+	}
+
+	return iterator, nil
+}
+
+// Calculates the number of iterations when using [FloatStep].
+//
+// In case of NaN or infinite arguments, zero or negative step, too many values or too
+// small step, an error is returned.
+func FloatStepSize[Flt constraints.Float](begin, end, step Flt) (uint64, error) {
+	last, err := floatStepLast(begin, end, step)
+	if err != nil {
+		return 0, err
+	}
+
+	return last + 1, nil
+}
+
+// Calculates the index of the last value of the range when using [FloatStep].
+func floatStepLast[Flt constraints.Float](begin, end, step Flt) (uint64, error) {
+	if err := checkFloats(begin, end, step); err != nil {
+		return 0, err
+	}
+
+	if step < 0 {
+		return 0, ErrStepNegative
+	}
+
+	if step == 0 {
+		return 0, ErrStepZero
+	}
+
+	// Estimate is calculated with increased precision
+	distance := math.Abs(float64(end) - float64(begin))
+	estimate := distance / float64(step)
+
+	// Distance between the maximum floating point numbers of different signs
+	// exceeds the maximum floating point number
+	if math.IsInf(distance, 0) {
+		estimate = math.Abs(float64(end)/float64(step) - float64(begin)/float64(step))
+	}
+
+	estimate = math.Floor(estimate)
+
+	if estimate >= float64(maxExactFloatIndex[Flt]()) {
+		return 0, ErrPrecisionLoss
+	}
+
+	last := uint64(estimate)
+
+	// Estimate may differ from the exact value by one due to rounding errors, so it
+	// is adjusted so that the last value does not go beyond the end, and the next
+	// value goes beyond it. If the step is lost when added, the next value does not
+	// go beyond the end, but it repeats the last one, so it is not counted
+	for last != 0 && !floatStepWithin(begin, end, step, last) {
+		last--
+	}
+
+	for last < maxExactFloatIndex[Flt]() && floatStepNext(begin, end, step, last) {
+		last++
+	}
+
+	if last >= maxExactFloatIndex[Flt]() {
+		return 0, ErrPrecisionLoss
+	}
+
+	if last == 0 {
+		return 0, nil
+	}
+
+	// Step less than the distance between adjacent floating point numbers is lost
+	// when added, so values of the range would repeat. Distance between adjacent
+	// floating point numbers is the largest at one of the ends of the range, so the
+	// step is checked at both ends
+	if floatStepPoint(begin, end, step, 1) == begin {
+		return 0, ErrPrecisionLoss
+	}
+
+	if floatStepPoint(begin, end, step, last) == floatStepPoint(begin, end, step, last-1) {
+		return 0, ErrPrecisionLoss
+	}
+
+	return last, nil
+}
+
+// Calculates the value of the range by its index. Calculation is performed with the
+// precision of the type, so the values are the same as in the case of calculation in
+// a regular loop using the index.
+func floatStepPoint[Flt constraints.Float](begin, end, step Flt, index uint64) Flt {
+	offset := Flt(index) * step
+
+	// Offset between the maximum floating point numbers of different signs exceeds
+	// the maximum floating point number, so the calculation is performed with
+	// halved values
+	if math.IsInf(float64(offset), 0) {
+		halved := Flt(index) * (step / 2)
+
+		if begin > end {
+			return (begin/2 - halved) * 2
+		}
+
+		return (begin/2 + halved) * 2
+	}
+
+	if begin > end {
+		return begin - offset
+	}
+
+	return begin + offset
+}
+
+// Detects whether the value of the range with the specified index does not go
+// beyond the end.
+func floatStepWithin[Flt constraints.Float](begin, end, step Flt, index uint64) bool {
+	point := floatStepPoint(begin, end, step, index)
+
+	if begin > end {
+		return point >= end
+	}
+
+	return point <= end
+}
+
+// Detects whether the value of the range next to the value with the specified index
+// differs from it and does not go beyond the end.
+func floatStepNext[Flt constraints.Float](begin, end, step Flt, index uint64) bool {
+	if !floatStepWithin(begin, end, step, index+1) {
+		return false
+	}
+
+	return floatStepPoint(begin, end, step, index+1) != floatStepPoint(begin, end, step, index)
+}
+
+// Returns the maximum index exactly representable by the floating point type such
+// that all smaller indices are also exactly representable.
+func maxExactFloatIndex[Flt constraints.Float]() uint64 {
+	const (
+		maxExactFloat32Index = 1 << 24
+		maxExactFloat64Index = 1 << 53
+	)
+
+	probe := Flt(maxExactFloat32Index)
+
+	if probe+1 == probe {
+		return maxExactFloat32Index
+	}
+
+	return maxExactFloat64Index
+}
+
+// An iterator over the specified number of evenly spaced floating point values from
+// begin to end inclusive, like linspace in other languages.
+//
+// Each value is calculated from its integer index rather than by accumulating
+// the spacing, so the first and last values are exactly equal to begin and end.
+//
+// If count is zero, then no one iteration of the loop will occur. If count is one,
+// then only begin is returned.
+//
+// In addition to the main value, its index in the sequence is returned.
+//
+// Indices must be exactly representable by float64, so the count must not exceed
+// 2^53.
+//
+// In case of NaN or infinite arguments or too large count, an error is returned.
+func Linspace[Flt constraints.Float](begin, end Flt, count uint64) (iter.Seq2[uint64, Flt], error) {
+	if err := checkFloats(begin, end); err != nil {
+		return nil, err
+	}
+
+	if count > maxExactFloatIndex[float64]() {
+		return nil, ErrPrecisionLoss
+	}
+
+	iterator := func(yield func(uint64, Flt) bool) {
+		for id := range count {
+			if !yield(id, linspacePoint(begin, end, count-1, id)) {
+				return
+			}
+		}
+	}
+
+	return iterator, nil
+}
+
+// Calculates the value of evenly spaced sequence by its index.
+func linspacePoint[Flt constraints.Float](begin, end Flt, last, index uint64) Flt {
+	switch index {
+	case 0:
+		return begin
+	case last:
+		return end
+	}
+
+	fraction := float64(index) / float64(last)
+	distance := float64(end) - float64(begin)
+
+	// Distance between the maximum floating point numbers of different signs
+	// exceeds the maximum floating point number
+	if math.IsInf(distance, 0) {
+		return Flt(float64(begin)*(1-fraction) + float64(end)*fraction)
+	}
+
+	return Flt(float64(begin) + distance*fraction)
+}
+
+// Checks that floating point numbers are neither NaN nor infinite.
+func checkFloats[Flt constraints.Float](numbers ...Flt) error {
+	for _, number := range numbers {
+		if math.IsNaN(float64(number)) {
+			return ErrNaN
+		}
+
+		if math.IsInf(float64(number), 0) {
+			return ErrInfinity
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package safe
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+func TestFloatStep(t *testing.T) {
+	actual := collectFloatStep(t, 0, 1, 0.1)
+	require.Len(t, actual, 11)
+	require.InDelta(t, 0.3, actual[3], 1e-15)
+	require.Equal(t, 1.0, actual[10])
+
+	actual = collectFloatStep(t, 1, 0, 0.1)
+	require.Len(t, actual, 11)
+	require.Equal(t, 1.0, actual[0])
+	require.InDelta(t, 0.0, actual[10], 1e-15)
+
+	actual = collectFloatStep(t, 0, 1, 0.3)
+	require.Len(t, actual, 4)
+
+	actual = collectFloatStep(t, 5, 5, 0.3)
+	require.Equal(t, []float64{5}, actual)
+
+	actual = collectFloatStep(t, -math.MaxFloat64, math.MaxFloat64, math.MaxFloat64)
+	require.Equal(t, []float64{-math.MaxFloat64, 0, math.MaxFloat64}, actual)
+
+	// Range of a single value, the step is lost when added to it
+	actual = collectFloatStep(t, 1e20, 1e20, 1)
+	require.Equal(t, []float64{1e20}, actual)
+
+	actual = collectFloatStep(t, 1e300, 1e300, 1e-300)
+	require.Equal(t, []float64{1e300}, actual)
+
+	actual32 := collectFloatStep[float32](t, 1e8, 1e8, 1)
+	require.Equal(t, []float32{1e8}, actual32)
+
+	actual32 = collectFloatStep[float32](t, 1e30, 1e30, 1)
+	require.Equal(t, []float32{1e30}, actual32)
+
+	actual32 = collectFloatStep[float32](t, 0, 1, 0.1)
+	require.Len(t, actual32, 11)
+	require.Equal(t, float32(1), actual32[10])
+}
+
+func TestFloatStepConsistency(t *testing.T) {
+	bounds := []float64{-1e10, -3, -1, -0.3, 0, 0.1, 0.7, 1, 2.5, 1e10}
+	steps := []float64{1e-3, 0.01, 0.1, 0.2, 0.3, 0.7, 1, 1.5, 3, 1e9}
+
+	for _, begin := range bounds {
+		for _, end := range bounds {
+			for _, step := range steps {
+				if math.Abs(end-begin)/step > 1e7 {
+					continue
+				}
+
+				testFloatStep(t, begin, end, step)
+
+				// Step is lost when added to begin due to the low precision of float32
+				if float32(begin)+float32(step) == float32(begin) {
+					continue
+				}
+
+				testFloatStep(t, float32(begin), float32(end), float32(step))
+			}
+		}
+	}
+}
+
+func testFloatStep[Flt constraints.Float](t *testing.T, begin, end, step Flt) {
+	iterator, err := FloatStep(begin, end, step)
+	require.NoError(t, err)
+
+	size, err := FloatStepSize(begin, end, step)
+	require.NoError(t, err)
+
+	forward := begin <= end
+
+	count := uint64(0)
+	previous := begin
+
+	for id, value := range iterator {
+		require.Equal(t, count, id)
+
+		if forward {
+			require.LessOrEqual(t, value, end)
+			require.GreaterOrEqual(t, value, previous)
+		} else {
+			require.GreaterOrEqual(t, value, end)
+			require.LessOrEqual(t, value, previous)
+		}
+
+		previous = value
+		count++
+	}
+
+	require.Equal(t, size, count, "begin: %v, end: %v, step: %v", begin, end, step)
+
+	// Next value, calculated with the precision of the type, goes beyond the end
+	if !forward {
+		next := begin - Flt(count)*step
+		require.Less(t, next, end, "begin: %v, end: %v, step: %v", begin, end, step)
+
+		return
+	}
+
+	next := begin + Flt(count)*step
+	require.Greater(t, next, end, "begin: %v, end: %v, step: %v", begin, end, step)
+}
+
+func TestFloatStepReference(t *testing.T) {
+	// Accumulation of the step leads to the loss of the end value
+	accumulated := []float64(nil)
+
+	for value := 0.0; value <= 1; value += 0.1 {
+		accumulated = append(accumulated, value)
+	}
+
+	require.NotEqual(t, 1.0, accumulated[len(accumulated)-1])
+
+	values := collectFloatStep(t, 0, 1, 0.1)
+	require.Equal(t, 1.0, values[len(values)-1])
+}
+
+func TestFloatStepError(t *testing.T) {
+	_, err := FloatStep(0, 1, 0.0)
+	require.ErrorIs(t, err, ErrStepZero)
+
+	_, err = FloatStep(0, 1, -0.1)
+	require.ErrorIs(t, err, ErrStepNegative)
+
+	_, err = FloatStep(math.NaN(), 1, 0.1)
+	require.ErrorIs(t, err, ErrNaN)
+
+	_, err = FloatStep(0, math.NaN(), 0.1)
+	require.ErrorIs(t, err, ErrNaN)
+
+	_, err = FloatStep(0, 1, math.NaN())
+	require.ErrorIs(t, err, ErrNaN)
+
+	_, err = FloatStep(0, math.Inf(1), 0.1)
+	require.ErrorIs(t, err, ErrInfinity)
+
+	_, err = FloatStep(math.Inf(-1), 0, 0.1)
+	require.ErrorIs(t, err, ErrInfinity)
+
+	_, err = FloatStep(0, 1, math.Inf(1))
+	require.ErrorIs(t, err, ErrInfinity)
+
+	_, err = FloatStep(0, 1, math.SmallestNonzeroFloat64)
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = FloatStepSize[float64](0, 1<<53, 1)
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+
+	size, err := FloatStepSize[float64](0, 1<<53-2, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<53-1), size)
+
+	_, err = FloatStepSize[float32](0, 1<<24, 1)
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+
+	size, err = FloatStepSize[float32](0, 1<<24-2, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<24-1), size)
+
+	_, err = FloatStep[float32](1e30, 0, 1)
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = FloatStepSize[float32](1e8, 1e8+8, 1)
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+
+	// Step is not lost near begin, but is lost near end
+	_, err = FloatStepSize[float32](1<<25-8, 1<<25+8, 2)
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+
+	size, err = FloatStepSize[float64](1e20, 1e20, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), size)
+
+	size, err = FloatStepSize[float32](1e8, 1e8, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), size)
+
+	_, err = Linspace[float64](0, 1, 1<<53+1)
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+}
+
+func collectFloatStep[Flt constraints.Float](t *testing.T, begin, end, step Flt) []Flt {
+	iterator, err := FloatStep(begin, end, step)
+	require.NoError(t, err)
+
+	collected := []Flt(nil)
+
+	for _, value := range iterator {
+		collected = append(collected, value)
+	}
+
+	return collected
+}
+
+func TestLinspace(t *testing.T) {
+	require.Empty(t, collectLinspace(t, 0.0, 1, 0))
+	require.Equal(t, []float64{3}, collectLinspace(t, 3.0, 1, 1))
+	require.Equal(t, []float64{0, 1}, collectLinspace(t, 0.0, 1, 2))
+	require.Equal(t, []float64{0, 0.25, 0.5, 0.75, 1}, collectLinspace(t, 0.0, 1, 5))
+	require.Equal(t, []float64{1, 0.5, 0}, collectLinspace(t, 1.0, 0, 3))
+	require.Equal(
+		t,
+		[]float64{-math.MaxFloat64, 0, math.MaxFloat64},
+		collectLinspace(t, -math.MaxFloat64, math.MaxFloat64, 3),
+	)
+
+	for _, count := range []uint64{3, 7, 10, 11, 100, 1000} {
+		for _, bounds := range [][2]float64{{0, 1}, {-1, 1}, {0.1, 0.7}, {1e10, -1e-10}} {
+			values := collectLinspace(t, bounds[0], bounds[1], count)
+			require.Len(t, values, int(count))
+			require.Equal(t, bounds[0], values[0])
+			require.Equal(t, bounds[1], values[len(values)-1])
+
+			for id := 1; id < len(values); id++ {
+				if bounds[0] < bounds[1] {
+					require.Greater(t, values[id], values[id-1])
+				} else {
+					require.Less(t, values[id], values[id-1])
+				}
+			}
+
+			values32 := collectLinspace(t, float32(bounds[0]), float32(bounds[1]), count)
+			require.Len(t, values32, int(count))
+			require.Equal(t, float32(bounds[0]), values32[0])
+			require.Equal(t, float32(bounds[1]), values32[len(values32)-1])
+		}
+	}
+}
+
+func TestLinspaceError(t *testing.T) {
+	_, err := Linspace(math.NaN(), 1, 2)
+	require.ErrorIs(t, err, ErrNaN)
+
+	_, err = Linspace(0, math.NaN(), 2)
+	require.ErrorIs(t, err, ErrNaN)
+
+	_, err = Linspace(0, math.Inf(1), 2)
+	require.ErrorIs(t, err, ErrInfinity)
+}
+
+func TestLinspacePart(t *testing.T) {
+	iterator, err := Linspace(0.0, 1, 5)
+	require.NoError(t, err)
+
+	for id := range iterator {
+		if id == 1 {
+			break
+		}
+	}
+}
+
+func collectLinspace[Flt constraints.Float](t *testing.T, begin, end Flt, count uint64) []Flt {
+	iterator, err := Linspace(begin, end, count)
+	require.NoError(t, err)
+
+	collected := []Flt(nil)
+
+	for _, value := range iterator {
+		collected = append(collected, value)
+	}
+
+	return collected
+}
+
+func BenchmarkFloatStep(b *testing.B) {
+	iterator, err := FloatStep(0, float64(b.N), 1)
+	require.NoError(b, err)
+
+	number := 0.0
+
+	for _, value := range iterator {
+		number = value
+	}
+
+	require.NotZero(b, number)
+}
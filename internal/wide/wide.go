@@ -0,0 +1,129 @@
+// Internal package with a signed 128-bit integer used as an accumulator that does not
+// overflow when summing up integers of any type from the standard library.
+package wide
+
+import "math/bits"
+
+// Signed 128-bit integer in two's complement representation.
+//
+// The zero value is zero.
+type Int struct {
+	high uint64
+	low  uint64
+}
+
+// Creates a 128-bit integer from the sign and the absolute value of an integer.
+func New(negative bool, abs uint64) Int {
+	number := Int{low: abs}
+
+	if negative {
+		return number.Neg()
+	}
+
+	return number
+}
+
+// Multiplies absolute values of two integers, applies a sign to the product and
+// detects whether an overflow has occurred or not.
+//
+// In case of overflow, false is returned.
+func Mul(negative bool, first, second uint64) (Int, bool) {
+	high, low := bits.Mul64(first, second)
+
+	// Absolute value of the product must not exceed 2^127-1 for positive products
+	// and 2^127 for negative ones, but the product of 64-bit integers is not equal
+	// to 2^127, so the same limit is used for both signs
+	if high>>63 != 0 {
+		return Int{}, false
+	}
+
+	product := Int{high: high, low: low}
+
+	if negative {
+		return product.Neg(), true
+	}
+
+	return product, true
+}
+
+// Detects whether the integer is negative.
+func (number Int) Negative() bool {
+	return int64(number.high) < 0
+}
+
+// Negates the integer. The minimum 128-bit integer is negated into itself.
+func (number Int) Neg() Int {
+	low, borrow := bits.Sub64(0, number.low, 0)
+	high, _ := bits.Sub64(0, number.high, borrow)
+
+	return Int{high: high, low: low}
+}
+
+// Adds two integers and detects whether an overflow has occurred or not.
+//
+// In case of overflow, false is returned.
+func (number Int) Add(addend Int) (Int, bool) {
+	low, carry := bits.Add64(number.low, addend.low, 0)
+	high, _ := bits.Add64(number.high, addend.high, carry)
+
+	sum := Int{high: high, low: low}
+
+	// Overflow occurs only when the addends have the same sign and the sign of the
+	// sum differs from it
+	if number.Negative() == addend.Negative() && sum.Negative() != number.Negative() {
+		return Int{}, false
+	}
+
+	return sum, true
+}
+
+// Adds two integers with wrapping around and returns the direction of the wrap: 1 if
+// the sum exceeds the maximum 128-bit integer, -1 if the sum is less than the minimum
+// 128-bit integer and 0 otherwise. So, the exact sum is equal to the returned sum
+// plus the direction multiplied by 2^128.
+func (number Int) AddWrap(addend Int) (Int, int) {
+	low, carry := bits.Add64(number.low, addend.low, 0)
+	high, _ := bits.Add64(number.high, addend.high, carry)
+
+	sum := Int{high: high, low: low}
+
+	if number.Negative() != addend.Negative() || sum.Negative() == number.Negative() {
+		return sum, 0
+	}
+
+	if number.Negative() {
+		return sum, -1
+	}
+
+	return sum, 1
+}
+
+// Returns the sign and the absolute value of the integer split into the high and low
+// 64-bit parts.
+func (number Int) Abs() (bool, uint64, uint64) {
+	if number.Negative() {
+		negated := number.Neg()
+		return true, negated.high, negated.low
+	}
+
+	return false, number.high, number.low
+}
+
+// Divides the integer by a divisor with truncation towards zero and returns the
+// quotient and the absolute value of the remainder.
+//
+// The divisor must not be zero.
+func (number Int) QuoRem(divisor uint64) (Int, uint64) {
+	negative, high, low := number.Abs()
+
+	quotientHigh, remainder := bits.Div64(0, high, divisor)
+	quotientLow, remainder := bits.Div64(remainder, low, divisor)
+
+	quotient := Int{high: quotientHigh, low: quotientLow}
+
+	if negative {
+		return quotient.Neg(), remainder
+	}
+
+	return quotient, remainder
+}
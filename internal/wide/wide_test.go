@@ -0,0 +1,166 @@
+package wide
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func toBig(number Int) *big.Int {
+	negative, high, low := number.Abs()
+
+	abs := new(big.Int).SetUint64(high)
+	abs.Lsh(abs, 64)
+	abs.Or(abs, new(big.Int).SetUint64(low))
+
+	if negative {
+		abs.Neg(abs)
+	}
+
+	return abs
+}
+
+func fromBig(number *big.Int) Int {
+	abs := new(big.Int).Abs(number)
+	low := new(big.Int).And(abs, new(big.Int).SetUint64(math.MaxUint64)).Uint64()
+	high := new(big.Int).Rsh(abs, 64).Uint64()
+
+	converted := Int{high: high, low: low}
+
+	if number.Sign() < 0 {
+		return converted.Neg()
+	}
+
+	return converted
+}
+
+func dataset() []Int {
+	minimum := Int{high: 1 << 63}
+	maximum := minimum.Neg()
+	maximum, _ = maximum.Add(New(true, 1))
+
+	return []Int{
+		{},
+		New(false, 1),
+		New(true, 1),
+		New(false, math.MaxUint64),
+		New(true, math.MaxUint64),
+		New(false, 1<<63),
+		New(true, 1<<63),
+		{high: 1},
+		{high: 1, low: math.MaxUint64},
+		{high: math.MaxUint64 >> 2, low: 12345},
+		minimum,
+		maximum,
+		minimum.Neg(),
+	}
+}
+
+func TestNew(t *testing.T) {
+	require.Equal(t, "0", toBig(New(false, 0)).String())
+	require.Equal(t, "0", toBig(New(true, 0)).String())
+	require.Equal(t, "-5", toBig(New(true, 5)).String())
+	require.Equal(t, "18446744073709551615", toBig(New(false, math.MaxUint64)).String())
+	require.Equal(t, "-18446744073709551615", toBig(New(true, math.MaxUint64)).String())
+}
+
+func TestAdd(t *testing.T) {
+	lower := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+	upper := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+
+	for _, first := range dataset() {
+		for _, second := range dataset() {
+			expected := new(big.Int).Add(toBig(first), toBig(second))
+
+			sum, ok := first.Add(second)
+
+			if expected.Cmp(lower) < 0 || expected.Cmp(upper) > 0 {
+				require.False(t, ok, "first: %v, second: %v", toBig(first), toBig(second))
+				continue
+			}
+
+			require.True(t, ok, "first: %v, second: %v", toBig(first), toBig(second))
+			require.Equal(t, expected.String(), toBig(sum).String(), "first: %v, second: %v", toBig(first), toBig(second))
+			require.Equal(t, fromBig(expected), sum)
+		}
+	}
+}
+
+func TestAddWrap(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 128)
+
+	for _, first := range dataset() {
+		for _, second := range dataset() {
+			expected := new(big.Int).Add(toBig(first), toBig(second))
+
+			sum, wrap := first.AddWrap(second)
+
+			actual := new(big.Int).Mul(big.NewInt(int64(wrap)), modulus)
+			actual.Add(actual, toBig(sum))
+
+			require.Equal(t, expected.String(), actual.String(), "first: %v, second: %v", toBig(first), toBig(second))
+
+			checked, ok := first.Add(second)
+			require.Equal(t, wrap == 0, ok)
+
+			if ok {
+				require.Equal(t, checked, sum)
+			}
+		}
+	}
+}
+
+func TestMul(t *testing.T) {
+	lower := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+	upper := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+
+	factors := []uint64{0, 1, 2, 3, 1 << 32, 1<<63 - 1, 1 << 63, 1<<63 + 1, math.MaxUint64 - 1, math.MaxUint64}
+
+	for _, negative := range []bool{false, true} {
+		for _, first := range factors {
+			for _, second := range factors {
+				expected := new(big.Int).Mul(new(big.Int).SetUint64(first), new(big.Int).SetUint64(second))
+
+				if negative {
+					expected.Neg(expected)
+				}
+
+				product, ok := Mul(negative, first, second)
+
+				if expected.Cmp(lower) < 0 || expected.Cmp(upper) > 0 {
+					require.False(t, ok, "first: %v, second: %v, negative: %v", first, second, negative)
+					continue
+				}
+
+				require.True(t, ok, "first: %v, second: %v, negative: %v", first, second, negative)
+				require.Equal(t, expected.String(), toBig(product).String())
+			}
+		}
+	}
+}
+
+func TestQuoRem(t *testing.T) {
+	divisors := []uint64{1, 2, 3, 7, 1 << 32, math.MaxUint64 - 1, math.MaxUint64}
+
+	for _, number := range dataset() {
+		for _, divisor := range divisors {
+			expected, remainder := new(big.Int).QuoRem(toBig(number), new(big.Int).SetUint64(divisor), new(big.Int))
+
+			quotient, actual := number.QuoRem(divisor)
+			require.Equal(t, expected.String(), toBig(quotient).String(), "number: %v, divisor: %v", toBig(number), divisor)
+			require.Equal(t, remainder.Abs(remainder).Uint64(), actual)
+		}
+	}
+}
+
+func BenchmarkAdd(b *testing.B) {
+	sum := Int{}
+
+	for range b.N {
+		sum, _ = sum.Add(New(false, math.MaxUint64))
+	}
+
+	require.NotZero(b, sum)
+}
@@ -0,0 +1,233 @@
+package safe
+
+import (
+	"errors"
+	"iter"
+
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
+
+// Closed integer interval [Min, Max] with checked arithmetic operations. Used to
+// propagate ranges of possible values through calculations.
+//
+// Arithmetic operations return the exact bounds of the resulting interval. If one of
+// the bounds does not fit into the type, it is saturated to the minimum or maximum
+// value for the type and an error [ErrOverflowMin] or [ErrOverflowMax] is returned,
+// indicating the direction of overflow. If both bounds are overflowed, both errors
+// are returned joined. These errors wrap [ErrOverflow].
+//
+// The zero value is an interval containing only zero.
+type Interval[Type constraints.Integer] struct {
+	lower Type
+	upper Type
+}
+
+// Creates an interval between two integers. The order of the arguments does not
+// matter.
+func NewInterval[Type constraints.Integer](first, second Type) Interval[Type] {
+	if first > second {
+		first, second = second, first
+	}
+
+	ivl := Interval[Type]{
+		lower: first,
+		upper: second,
+	}
+
+	return ivl
+}
+
+// Returns the lower bound of the interval.
+func (ivl Interval[Type]) Min() Type {
+	return ivl.lower
+}
+
+// Returns the upper bound of the interval.
+func (ivl Interval[Type]) Max() Type {
+	return ivl.upper
+}
+
+// Detects whether the interval contains a number or not.
+func (ivl Interval[Type]) Contains(number Type) bool {
+	return number >= ivl.lower && number <= ivl.upper
+}
+
+// Returns the intersection of two intervals. If the intervals do not intersect,
+// then false is returned.
+func (ivl Interval[Type]) Intersect(other Interval[Type]) (Interval[Type], bool) {
+	lower := max(ivl.lower, other.lower)
+	upper := min(ivl.upper, other.upper)
+
+	if lower > upper {
+		return Interval[Type]{}, false
+	}
+
+	return Interval[Type]{lower: lower, upper: upper}, true
+}
+
+// Returns the smallest interval containing both intervals.
+func (ivl Interval[Type]) Hull(other Interval[Type]) Interval[Type] {
+	hull := Interval[Type]{
+		lower: min(ivl.lower, other.lower),
+		upper: max(ivl.upper, other.upper),
+	}
+
+	return hull
+}
+
+// A range iterator over integer values of the interval in ascending order. See [Iter].
+func (ivl Interval[Type]) Iter() iter.Seq[Type] {
+	return Iter(ivl.lower, ivl.upper)
+}
+
+// Calculates the number of integer values in the interval. See [IterSize].
+func (ivl Interval[Type]) Size() uint64 {
+	return IterSize(ivl.lower, ivl.upper)
+}
+
+// Adds two intervals and detects whether an overflow of their bounds has occurred
+// or not.
+//
+// In case of overflow, the saturated interval and an error are returned.
+func (ivl Interval[Type]) Add(addend Interval[Type]) (Interval[Type], error) {
+	bounds := intervalBounds[Type]{}
+
+	lower, err := Add(ivl.lower, addend.lower)
+	bounds.include(lower, err, addend.lower > 0)
+
+	upper, err := Add(ivl.upper, addend.upper)
+	bounds.include(upper, err, addend.upper > 0)
+
+	return bounds.result()
+}
+
+// Subtracts two intervals (subtrahend from ivl) and detects whether an overflow of
+// their bounds has occurred or not.
+//
+// In case of overflow, the saturated interval and an error are returned.
+func (ivl Interval[Type]) Sub(subtrahend Interval[Type]) (Interval[Type], error) {
+	bounds := intervalBounds[Type]{}
+
+	lower, err := Sub(ivl.lower, subtrahend.upper)
+	bounds.include(lower, err, subtrahend.upper < 0)
+
+	upper, err := Sub(ivl.upper, subtrahend.lower)
+	bounds.include(upper, err, subtrahend.lower < 0)
+
+	return bounds.result()
+}
+
+// Multiplies two intervals and detects whether an overflow of their bounds has
+// occurred or not.
+//
+// In case of overflow, the saturated interval and an error are returned.
+func (ivl Interval[Type]) Mul(factor Interval[Type]) (Interval[Type], error) {
+	bounds := intervalBounds[Type]{}
+
+	// Bounds of the product are reached at the bounds of the factors
+	for _, first := range [...]Type{ivl.lower, ivl.upper} {
+		for _, second := range [...]Type{factor.lower, factor.upper} {
+			product, err := Mul(first, second)
+
+			// Overflow of a product of factors with the same signs is positive
+			bounds.include(product, err, first < 0 == (second < 0))
+		}
+	}
+
+	return bounds.result()
+}
+
+// Divides two intervals (ivl to divisor) and detects whether an overflow of their
+// bounds has occurred or not.
+//
+// Divisor may contain zero, in this case zero is excluded from it. If the divisor
+// contains only zero, an error is returned.
+//
+// In case of overflow, the saturated interval and an error are returned.
+func (ivl Interval[Type]) Div(divisor Interval[Type]) (Interval[Type], error) {
+	if divisor.lower == 0 && divisor.upper == 0 {
+		return Interval[Type]{}, ErrDivisionByZero
+	}
+
+	bounds := intervalBounds[Type]{}
+
+	// The quotient is monotonic with respect to the divisor on each side of zero,
+	// so the divisor is split into negative and positive parts, and the bounds of
+	// the quotient are reached at the bounds of the dividend and these parts
+	if divisor.lower < 0 {
+		ivl.divide(&bounds, divisor.lower, min(divisor.upper, -Type(1)))
+	}
+
+	if divisor.upper > 0 {
+		ivl.divide(&bounds, max(divisor.lower, 1), divisor.upper)
+	}
+
+	return bounds.result()
+}
+
+func (ivl Interval[Type]) divide(bounds *intervalBounds[Type], lower, upper Type) {
+	for _, dividend := range [...]Type{ivl.lower, ivl.upper} {
+		for _, divisor := range [...]Type{lower, upper} {
+			quotient, err := Div(dividend, divisor)
+
+			// The only possible overflow of division (minimum value divided by -1)
+			// is positive
+			bounds.include(quotient, err, true)
+		}
+	}
+}
+
+// Accumulates candidates for the bounds of an interval, saturating overflowed values.
+type intervalBounds[Type constraints.Integer] struct {
+	lower Type
+	upper Type
+
+	filled bool
+
+	overflowMax bool
+	overflowMin bool
+}
+
+func (bnd *intervalBounds[Type]) include(value Type, err error, positive bool) {
+	if err != nil {
+		minimum, maximum := intspec.Range[Type]()
+
+		if positive {
+			bnd.overflowMax = true
+			value = maximum
+		} else {
+			bnd.overflowMin = true
+			value = minimum
+		}
+	}
+
+	if !bnd.filled {
+		bnd.filled = true
+		bnd.lower = value
+		bnd.upper = value
+
+		return
+	}
+
+	bnd.lower = min(bnd.lower, value)
+	bnd.upper = max(bnd.upper, value)
+}
+
+func (bnd *intervalBounds[Type]) result() (Interval[Type], error) {
+	ivl := Interval[Type]{
+		lower: bnd.lower,
+		upper: bnd.upper,
+	}
+
+	switch {
+	case bnd.overflowMin && bnd.overflowMax:
+		return ivl, errors.Join(ErrOverflowMin, ErrOverflowMax)
+	case bnd.overflowMin:
+		return ivl, ErrOverflowMin
+	case bnd.overflowMax:
+		return ivl, ErrOverflowMax
+	}
+
+	return ivl, nil
+}
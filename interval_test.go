@@ -0,0 +1,216 @@
+package safe
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/akramarenkov/intspec"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+func TestNewInterval(t *testing.T) {
+	ivl := NewInterval[int8](3, -2)
+	require.Equal(t, int8(-2), ivl.Min())
+	require.Equal(t, int8(3), ivl.Max())
+
+	ivl = NewInterval[int8](-2, 3)
+	require.Equal(t, int8(-2), ivl.Min())
+	require.Equal(t, int8(3), ivl.Max())
+
+	zero := Interval[int8]{}
+	require.Equal(t, int8(0), zero.Min())
+	require.Equal(t, int8(0), zero.Max())
+	require.True(t, zero.Contains(0))
+	require.Equal(t, uint64(1), zero.Size())
+}
+
+func TestIntervalContains(t *testing.T) {
+	ivl := NewInterval[int8](-2, 3)
+
+	for number := range Iter[int8](math.MinInt8, math.MaxInt8) {
+		require.Equal(t, number >= -2 && number <= 3, ivl.Contains(number), "number: %v", number)
+	}
+}
+
+func TestIntervalIntersect(t *testing.T) {
+	intersection, intersects := NewInterval[int8](-2, 3).Intersect(NewInterval[int8](1, 10))
+	require.True(t, intersects)
+	require.Equal(t, NewInterval[int8](1, 3), intersection)
+
+	intersection, intersects = NewInterval[int8](-2, 3).Intersect(NewInterval[int8](3, 10))
+	require.True(t, intersects)
+	require.Equal(t, NewInterval[int8](3, 3), intersection)
+
+	intersection, intersects = NewInterval[int8](-2, 3).Intersect(NewInterval[int8](-1, 1))
+	require.True(t, intersects)
+	require.Equal(t, NewInterval[int8](-1, 1), intersection)
+
+	_, intersects = NewInterval[int8](-2, 3).Intersect(NewInterval[int8](4, 10))
+	require.False(t, intersects)
+
+	_, intersects = NewInterval[int8](4, 10).Intersect(NewInterval[int8](-2, 3))
+	require.False(t, intersects)
+}
+
+func TestIntervalHull(t *testing.T) {
+	require.Equal(
+		t,
+		NewInterval[int8](-2, 10),
+		NewInterval[int8](-2, 3).Hull(NewInterval[int8](5, 10)),
+	)
+
+	require.Equal(
+		t,
+		NewInterval[int8](-128, 127),
+		NewInterval[int8](127, 127).Hull(NewInterval[int8](-128, -128)),
+	)
+}
+
+func TestIntervalIter(t *testing.T) {
+	ivl := NewInterval[int8](math.MaxInt8, math.MinInt8)
+
+	reference := int(math.MinInt8)
+
+	for number := range ivl.Iter() {
+		require.Equal(t, reference, int(number))
+
+		reference++
+	}
+
+	require.Equal(t, math.MaxInt8+1, reference)
+	require.Equal(t, uint64(256), ivl.Size())
+}
+
+func TestIntervalArithmeticSig(t *testing.T) {
+	testIntervalArithmetic(t, []int8{-128, -127, -64, -3, -1, 0, 1, 2, 63, 126, 127})
+}
+
+func TestIntervalArithmeticUns(t *testing.T) {
+	testIntervalArithmetic(t, []uint8{0, 1, 2, 3, 64, 127, 128, 200, 254, 255})
+}
+
+func testIntervalArithmetic[Type constraints.Integer](t *testing.T, bounds []Type) {
+	intervals := make([]Interval[Type], 0, len(bounds)*len(bounds))
+
+	for id, first := range bounds {
+		for _, second := range bounds[id:] {
+			intervals = append(intervals, NewInterval(first, second))
+		}
+	}
+
+	for _, first := range intervals {
+		for _, second := range intervals {
+			sum, err := first.Add(second)
+			testIntervalResult(
+				t,
+				sum,
+				err,
+				int64(first.Min())+int64(second.Min()),
+				int64(first.Max())+int64(second.Max()),
+			)
+
+			diff, err := first.Sub(second)
+			testIntervalResult(
+				t,
+				diff,
+				err,
+				int64(first.Min())-int64(second.Max()),
+				int64(first.Max())-int64(second.Min()),
+			)
+
+			lower, upper := referenceIntervalMul(first, second)
+
+			product, err := first.Mul(second)
+			testIntervalResult(t, product, err, lower, upper)
+
+			if second.Min() == 0 && second.Max() == 0 {
+				_, err := first.Div(second)
+				require.ErrorIs(t, err, ErrDivisionByZero)
+
+				continue
+			}
+
+			lower, upper = referenceIntervalDiv(first, second)
+
+			quotient, err := first.Div(second)
+			testIntervalResult(t, quotient, err, lower, upper)
+		}
+	}
+}
+
+func referenceIntervalMul[Type constraints.Integer](first, second Interval[Type]) (int64, int64) {
+	lower := int64(math.MaxInt64)
+	upper := int64(math.MinInt64)
+
+	for _, left := range []Type{first.Min(), first.Max()} {
+		for _, right := range []Type{second.Min(), second.Max()} {
+			product := int64(left) * int64(right)
+
+			lower = min(lower, product)
+			upper = max(upper, product)
+		}
+	}
+
+	return lower, upper
+}
+
+func referenceIntervalDiv[Type constraints.Integer](first, second Interval[Type]) (int64, int64) {
+	lower := int64(math.MaxInt64)
+	upper := int64(math.MinInt64)
+
+	for _, dividend := range []Type{first.Min(), first.Max()} {
+		for divisor := range second.Iter() {
+			if divisor == 0 {
+				continue
+			}
+
+			quotient := int64(dividend) / int64(divisor)
+
+			lower = min(lower, quotient)
+			upper = max(upper, quotient)
+		}
+	}
+
+	return lower, upper
+}
+
+func testIntervalResult[Type constraints.Integer](
+	t *testing.T,
+	actual Interval[Type],
+	err error,
+	lower int64,
+	upper int64,
+) {
+	minimum, maximum := intspec.Range[Type]()
+
+	overflowMin := lower < int64(minimum)
+	overflowMax := upper > int64(maximum)
+
+	require.Equal(t, overflowMin, errors.Is(err, ErrOverflowMin), "lower: %v, upper: %v", lower, upper)
+	require.Equal(t, overflowMax, errors.Is(err, ErrOverflowMax), "lower: %v, upper: %v", lower, upper)
+
+	if overflowMin || overflowMax {
+		require.ErrorIs(t, err, ErrOverflow)
+	} else {
+		require.NoError(t, err)
+	}
+
+	require.Equal(
+		t,
+		max(min(lower, int64(maximum)), int64(minimum)),
+		int64(actual.Min()),
+		"lower: %v, upper: %v",
+		lower,
+		upper,
+	)
+	require.Equal(
+		t,
+		max(min(upper, int64(maximum)), int64(minimum)),
+		int64(actual.Max()),
+		"lower: %v, upper: %v",
+		lower,
+		upper,
+	)
+}
@@ -342,3 +342,352 @@ func DecStepSize[Type constraints.Integer](begin, end, step Type) uint64 {
 
 	return size
 }
+
+// A range iterator for safely (without infinite loops due to counter overflow)
+// iterating over integer values from begin to end inclusive (if the begin-end
+// range is a multiple of the step) with the ability to specify the iteration step.
+//
+// Unlike [Step], the step can be of an integer type other than the type of begin and
+// end, for example, a uint64 step over an int8 range, and an invalid step does not
+// lead to panic.
+//
+// If begin is greater than end, the return value will be decremented, otherwise it
+// will be incremented.
+//
+// As in a regular loop, if the begin-end range is not a multiple of the step, the end
+// value will not be returned.
+//
+// In addition to the main integer, its index in the begin-end sequence is returned.
+//
+// In case of zero or negative step, an error is returned.
+func StepE[Type, TypeStep constraints.Integer](
+	begin Type,
+	end Type,
+	step TypeStep,
+) (iter.Seq2[uint64, Type], error) {
+	stepU64, err := checkStep(step)
+	if err != nil {
+		return nil, err
+	}
+
+	return stepIter(begin, end, stepU64, begin <= end), nil
+}
+
+// Calculates the number of iterations when using [StepE]. The return value
+// is intended to be used as the size parameter in the make call, so, and because
+// the maximum possible number of iterations is one more than the maximum value for
+// uint64, the return value is truncated to the maximum value for uint64 if the
+// calculated value exceeds it.
+//
+// In case of zero or negative step, an error is returned.
+func StepSizeE[Type, TypeStep constraints.Integer](begin, end Type, step TypeStep) (uint64, error) {
+	stepU64, err := checkStep(step)
+	if err != nil {
+		return 0, err
+	}
+
+	return stepSize(begin, end, stepU64), nil
+}
+
+// A range iterator for safely (without infinite loops due to counter overflow)
+// iterating over integer values from begin to end inclusive (if the begin-end
+// range is a multiple of the step) towards increase with the ability to specify
+// the iteration step.
+//
+// Unlike [IncStep], the step can be of an integer type other than the type of begin
+// and end, and an invalid step does not lead to panic.
+//
+// If begin is greater than end, then no one iteration of the loop will occur.
+//
+// As in a regular loop, if the begin-end range is not a multiple of the step, the end
+// value will not be returned.
+//
+// In addition to the main integer, its index in the begin-end sequence is returned.
+//
+// In case of zero or negative step, an error is returned.
+func IncStepE[Type, TypeStep constraints.Integer](
+	begin Type,
+	end Type,
+	step TypeStep,
+) (iter.Seq2[uint64, Type], error) {
+	stepU64, err := checkStep(step)
+	if err != nil {
+		return nil, err
+	}
+
+	if begin > end {
+		return emptySeq2[Type], nil
+	}
+
+	return stepIter(begin, end, stepU64, true), nil
+}
+
+// Calculates the number of iterations when using [IncStepE]. The return value
+// is intended to be used as the size parameter in the make call, so, and because
+// the maximum possible number of iterations is one more than the maximum value for
+// uint64, the return value is truncated to the maximum value for uint64 if the
+// calculated value exceeds it.
+//
+// In case of zero or negative step, an error is returned.
+func IncStepSizeE[Type, TypeStep constraints.Integer](
+	begin Type,
+	end Type,
+	step TypeStep,
+) (uint64, error) {
+	stepU64, err := checkStep(step)
+	if err != nil {
+		return 0, err
+	}
+
+	if begin > end {
+		return 0, nil
+	}
+
+	return stepSize(begin, end, stepU64), nil
+}
+
+// A range iterator for safely (without infinite loops due to counter overflow)
+// iterating over integer values from begin to end inclusive (if the begin-end
+// range is a multiple of the step) towards decrease with the ability to specify
+// the iteration step.
+//
+// Unlike [DecStep], the step can be of an integer type other than the type of begin
+// and end, and an invalid step does not lead to panic.
+//
+// If begin is lesser than end, then no one iteration of the loop will occur.
+//
+// As in a regular loop, if the begin-end range is not a multiple of the step, the end
+// value will not be returned.
+//
+// In addition to the main integer, its index in the begin-end sequence is returned.
+//
+// In case of zero or negative step, an error is returned.
+func DecStepE[Type, TypeStep constraints.Integer](
+	begin Type,
+	end Type,
+	step TypeStep,
+) (iter.Seq2[uint64, Type], error) {
+	stepU64, err := checkStep(step)
+	if err != nil {
+		return nil, err
+	}
+
+	if begin < end {
+		return emptySeq2[Type], nil
+	}
+
+	return stepIter(begin, end, stepU64, false), nil
+}
+
+// Calculates the number of iterations when using [DecStepE]. The return value
+// is intended to be used as the size parameter in the make call, so, and because
+// the maximum possible number of iterations is one more than the maximum value for
+// uint64, the return value is truncated to the maximum value for uint64 if the
+// calculated value exceeds it.
+//
+// In case of zero or negative step, an error is returned.
+func DecStepSizeE[Type, TypeStep constraints.Integer](
+	begin Type,
+	end Type,
+	step TypeStep,
+) (uint64, error) {
+	stepU64, err := checkStep(step)
+	if err != nil {
+		return 0, err
+	}
+
+	if begin < end {
+		return 0, nil
+	}
+
+	return stepSize(begin, end, stepU64), nil
+}
+
+func checkStep[Type constraints.Integer](step Type) (uint64, error) {
+	if step < 0 {
+		return 0, ErrStepNegative
+	}
+
+	if step == 0 {
+		return 0, ErrStepZero
+	}
+
+	return uint64(step), nil
+}
+
+// Iterates from begin to end with a step in specified direction. The begin-end
+// direction must correspond to the specified one.
+func stepIter[Type constraints.Integer](
+	begin Type,
+	end Type,
+	step uint64,
+	forward bool,
+) iter.Seq2[uint64, Type] {
+	iterator := func(yield func(uint64, Type) bool) {
+		// Remaining distance to the end is tracked instead of comparing the number
+		// with the end, so there is no need to detect overflow of the number
+		remaining := Dist(begin, end)
+		number := begin
+
+		for id := uint64(0); ; id++ {
+			if !yield(id, number) {
+				return
+			}
+
+			if remaining < step {
+				return
+			}
+
+			remaining -= step
+
+			// The step may not fit into the type and becomes truncated when
+			// converted, but since the new value of the number lies between begin
+			// and end, the result of addition or subtraction with wrapping around is
+			// correct
+			if forward {
+				number += Type(step)
+			} else {
+				number -= Type(step)
+			}
+		}
+	}
+
+	return iterator
+}
+
+func stepSize[Type constraints.Integer](begin, end Type, step uint64) uint64 {
+	size := Dist(begin, end) / step
+
+	if size == intspec.MaxUint64 {
+		return size
+	}
+
+	// +1 due to the constant presence of iteration on the begin value
+	return size + 1
+}
+
+func emptySeq2[Type constraints.Integer](func(uint64, Type) bool) {}
+
+// A range iterator for safely (without infinite loops due to counter overflow)
+// iterating over integer values from begin to end inclusive (if the begin-end
+// range is a multiple of the step) with a signed step, like range(begin, end, step)
+// in other languages, but with the end included.
+//
+// The direction of iteration is determined by the sign of the step. If the step
+// points away from end, then no one iteration of the loop will occur.
+//
+// As in a regular loop, if the begin-end range is not a multiple of the step, the end
+// value will not be returned.
+//
+// In addition to the main integer, its index in the begin-end sequence is returned.
+//
+// In case of zero step, an error is returned.
+func Stride[Type, TypeStep constraints.Integer](
+	begin Type,
+	end Type,
+	step TypeStep,
+) (iter.Seq2[uint64, Type], error) {
+	if step == 0 {
+		return nil, ErrStepZero
+	}
+
+	if step > 0 {
+		if begin > end {
+			return emptySeq2[Type], nil
+		}
+
+		return stepIter(begin, end, Abs(step), true), nil
+	}
+
+	if begin < end {
+		return emptySeq2[Type], nil
+	}
+
+	return stepIter(begin, end, Abs(step), false), nil
+}
+
+// Calculates the number of iterations when using [Stride]. The return value
+// is intended to be used as the size parameter in the make call, so, and because
+// the maximum possible number of iterations is one more than the maximum value for
+// uint64, the return value is truncated to the maximum value for uint64 if the
+// calculated value exceeds it.
+//
+// In case of zero step, an error is returned.
+func StrideSize[Type, TypeStep constraints.Integer](
+	begin Type,
+	end Type,
+	step TypeStep,
+) (uint64, error) {
+	if step == 0 {
+		return 0, ErrStepZero
+	}
+
+	if step > 0 && begin > end || step < 0 && begin < end {
+		return 0, nil
+	}
+
+	return stepSize(begin, end, Abs(step)), nil
+}
+
+// A range iterator for safely (without infinite loops due to counter overflow)
+// iterating over integer values from begin inclusive to end exclusive with a signed
+// step, exactly like range(begin, end, step) in other languages.
+//
+// The direction of iteration is determined by the sign of the step. If the step
+// points away from end or begin is equal to end, then no one iteration of the loop
+// will occur.
+//
+// In addition to the main integer, its index in the begin-end sequence is returned.
+//
+// In case of zero step, an error is returned.
+func StrideExclusive[Type, TypeStep constraints.Integer](
+	begin Type,
+	end Type,
+	step TypeStep,
+) (iter.Seq2[uint64, Type], error) {
+	if step == 0 {
+		return nil, ErrStepZero
+	}
+
+	if begin == end {
+		return emptySeq2[Type], nil
+	}
+
+	return Stride(begin, excludeEnd(begin, end, step), step)
+}
+
+// Calculates the number of iterations when using [StrideExclusive]. The return value
+// is intended to be used as the size parameter in the make call. Since the end is
+// excluded, the number of iterations always fits into uint64.
+//
+// In case of zero step, an error is returned.
+func StrideExclusiveSize[Type, TypeStep constraints.Integer](
+	begin Type,
+	end Type,
+	step TypeStep,
+) (uint64, error) {
+	if step == 0 {
+		return 0, ErrStepZero
+	}
+
+	if begin == end {
+		return 0, nil
+	}
+
+	return StrideSize(begin, excludeEnd(begin, end, step), step)
+}
+
+// Returns the end value adjacent to the specified one for a half-open range. If the
+// step points away from end, then the end value is returned unchanged.
+func excludeEnd[Type, TypeStep constraints.Integer](begin, end Type, step TypeStep) Type {
+	// Begin and end are not equal, so the end value is not the minimum or maximum
+	// value for the type in the direction of the step and there is no overflow
+	switch {
+	case step > 0 && begin < end:
+		return end - 1
+	case step < 0 && begin > end:
+		return end + 1
+	}
+
+	return end
+}
@@ -22,3 +22,17 @@ func ExampleStep() {
 	// Output:
 	// 126
 }
+
+func ExampleStepE() {
+	iterator, err := safe.StepE[int8](-128, 127, uint64(200))
+	if err != nil {
+		panic(err)
+	}
+
+	for _, number := range iterator {
+		fmt.Println(number)
+	}
+	// Output:
+	// -128
+	// 72
+}
@@ -1,10 +1,13 @@
 package safe
 
 import (
+	"iter"
 	"math"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
 )
 
 func TestIterForwardSig(t *testing.T) {
@@ -1354,3 +1357,358 @@ func BenchmarkDecStepSize(b *testing.B) {
 
 	require.NotZero(b, size)
 }
+
+func TestStepE(t *testing.T) {
+	for step := range Iter[uint64](1, math.MaxUint8+2) {
+		testStepE(t, int8(math.MinInt8), int8(math.MaxInt8), step)
+		testStepE(t, int8(math.MaxInt8), int8(math.MinInt8), step)
+		testStepE(t, int8(math.MaxInt8), int8(0), step)
+		testStepE(t, int8(-3), int8(-3), step)
+		testStepE(t, uint8(0), uint8(math.MaxUint8), step)
+		testStepE(t, uint8(math.MaxUint8), uint8(0), step)
+		testStepE(t, uint8(math.MaxUint8), uint8(math.MaxUint8/2), step)
+	}
+
+	for step := range Iter[int16](1, math.MaxInt16) {
+		testStepE(t, int8(math.MinInt8), int8(math.MaxInt8), step)
+		testStepE(t, uint8(math.MaxUint8), uint8(0), step)
+	}
+
+	testStepE(t, int64(math.MinInt64), int64(math.MaxInt64), uint64(math.MaxUint64))
+	testStepE(t, int64(math.MinInt64), int64(math.MaxInt64), uint64(math.MaxUint64/2))
+	testStepE(t, uint64(math.MaxUint64), uint64(0), uint64(math.MaxUint64/3))
+}
+
+func testStepE[Type, TypeStep constraints.Integer](t *testing.T, begin, end Type, step TypeStep) {
+	expected := referenceStepE(begin, end, step)
+
+	iterator, err := StepE(begin, end, step)
+	require.NoError(t, err)
+
+	actual := make([]Type, 0, len(expected))
+
+	for id, number := range iterator {
+		require.Equal(t, uint64(len(actual)), id)
+
+		actual = append(actual, number)
+	}
+
+	require.Equal(t, expected, actual, "begin: %v, end: %v, step: %v", begin, end, step)
+
+	size, err := StepSizeE(begin, end, step)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(expected)), size, "begin: %v, end: %v, step: %v", begin, end, step)
+
+	switch {
+	case begin == end:
+		testIncDecStepE(t, begin, end, step, expected, expected)
+	case begin < end:
+		testIncDecStepE(t, begin, end, step, expected, nil)
+	default:
+		testIncDecStepE(t, begin, end, step, nil, expected)
+	}
+}
+
+func testIncDecStepE[Type, TypeStep constraints.Integer](
+	t *testing.T,
+	begin Type,
+	end Type,
+	step TypeStep,
+	expectedInc []Type,
+	expectedDec []Type,
+) {
+	iterator, err := IncStepE(begin, end, step)
+	require.NoError(t, err)
+
+	actual := []Type(nil)
+
+	for _, number := range iterator {
+		actual = append(actual, number)
+	}
+
+	require.Equal(t, expectedInc, actual, "begin: %v, end: %v, step: %v", begin, end, step)
+
+	size, err := IncStepSizeE(begin, end, step)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(expectedInc)), size, "begin: %v, end: %v, step: %v", begin, end, step)
+
+	iterator, err = DecStepE(begin, end, step)
+	require.NoError(t, err)
+
+	actual = nil
+
+	for _, number := range iterator {
+		actual = append(actual, number)
+	}
+
+	require.Equal(t, expectedDec, actual, "begin: %v, end: %v, step: %v", begin, end, step)
+
+	size, err = DecStepSizeE(begin, end, step)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(expectedDec)), size, "begin: %v, end: %v, step: %v", begin, end, step)
+}
+
+func referenceStepE[Type, TypeStep constraints.Integer](begin, end Type, step TypeStep) []Type {
+	reference := []Type(nil)
+
+	first := new(big.Int).SetInt64(0)
+	last := new(big.Int).SetUint64(Dist(begin, end))
+	stride := new(big.Int).SetUint64(uint64(step))
+
+	for distance := first; distance.Cmp(last) <= 0; distance.Add(distance, stride) {
+		if begin <= end {
+			reference = append(reference, begin+Type(distance.Uint64()))
+			continue
+		}
+
+		reference = append(reference, begin-Type(distance.Uint64()))
+	}
+
+	return reference
+}
+
+func TestStepEError(t *testing.T) {
+	_, err := StepE[int8](1, 2, 0)
+	require.ErrorIs(t, err, ErrStepZero)
+
+	_, err = StepE[int8](1, 2, -1)
+	require.ErrorIs(t, err, ErrStepNegative)
+
+	_, err = StepSizeE[int8](1, 2, 0)
+	require.ErrorIs(t, err, ErrStepZero)
+
+	_, err = StepSizeE[int8](1, 2, -1)
+	require.ErrorIs(t, err, ErrStepNegative)
+
+	_, err = IncStepE[int8](1, 2, 0)
+	require.ErrorIs(t, err, ErrStepZero)
+
+	_, err = IncStepE[int8](1, 2, -1)
+	require.ErrorIs(t, err, ErrStepNegative)
+
+	_, err = IncStepSizeE[int8](1, 2, 0)
+	require.ErrorIs(t, err, ErrStepZero)
+
+	_, err = IncStepSizeE[int8](1, 2, -1)
+	require.ErrorIs(t, err, ErrStepNegative)
+
+	_, err = DecStepE[int8](1, 2, 0)
+	require.ErrorIs(t, err, ErrStepZero)
+
+	_, err = DecStepE[int8](1, 2, -1)
+	require.ErrorIs(t, err, ErrStepNegative)
+
+	_, err = DecStepSizeE[int8](1, 2, 0)
+	require.ErrorIs(t, err, ErrStepZero)
+
+	_, err = DecStepSizeE[int8](1, 2, -1)
+	require.ErrorIs(t, err, ErrStepNegative)
+}
+
+func TestStepEPart(t *testing.T) {
+	iterator, err := StepE[int8](math.MinInt8, math.MaxInt8, uint64(1))
+	require.NoError(t, err)
+
+	breakAt := int8(3)
+	reference := int8(math.MinInt8)
+
+	for _, number := range iterator {
+		require.Equal(t, reference, number)
+
+		if number == breakAt {
+			break
+		}
+
+		reference++
+	}
+
+	require.Equal(t, breakAt, reference)
+}
+
+func TestStepSizeEMax(t *testing.T) {
+	size, err := StepSizeE[int64](math.MinInt64, math.MaxInt64, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), size)
+
+	size, err = StepSizeE[int64](math.MinInt64, math.MaxInt64, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64/2+1), size)
+}
+
+func BenchmarkStepE(b *testing.B) {
+	number := 0
+
+	iterator, err := StepE(1, b.N, 1)
+	require.NoError(b, err)
+
+	for _, value := range iterator {
+		number = value
+	}
+
+	require.NotZero(b, number)
+}
+
+func BenchmarkStepSizeE(b *testing.B) {
+	size := uint64(0)
+
+	for range b.N {
+		size, _ = StepSizeE(1, b.N, 1)
+	}
+
+	require.NotZero(b, size)
+}
+
+func TestStride(t *testing.T) {
+	bounds := []int8{math.MinInt8, math.MinInt8 + 1, -3, -1, 0, 1, 5, math.MaxInt8 - 1, math.MaxInt8}
+	boundsU := []uint8{0, 1, 5, math.MaxUint8 - 1, math.MaxUint8}
+
+	for step := range Iter[int16](-math.MaxUint8-1, math.MaxUint8+1) {
+		if step == 0 {
+			continue
+		}
+
+		for _, begin := range bounds {
+			for _, end := range bounds {
+				testStride(t, begin, end, step)
+			}
+		}
+
+		for _, begin := range boundsU {
+			for _, end := range boundsU {
+				testStride(t, begin, end, step)
+			}
+		}
+	}
+}
+
+func testStride[Type, TypeStep constraints.Integer](t *testing.T, begin, end Type, step TypeStep) {
+	testStrideMode(t, begin, end, step, false, Stride[Type, TypeStep], StrideSize[Type, TypeStep])
+	testStrideMode(
+		t,
+		begin,
+		end,
+		step,
+		true,
+		StrideExclusive[Type, TypeStep],
+		StrideExclusiveSize[Type, TypeStep],
+	)
+}
+
+func testStrideMode[Type, TypeStep constraints.Integer](
+	t *testing.T,
+	begin Type,
+	end Type,
+	step TypeStep,
+	exclusive bool,
+	stride func(Type, Type, TypeStep) (iter.Seq2[uint64, Type], error),
+	strideSize func(Type, Type, TypeStep) (uint64, error),
+) {
+	expected := referenceStride(begin, end, step, exclusive)
+
+	iterator, err := stride(begin, end, step)
+	require.NoError(t, err)
+
+	actual := []Type(nil)
+
+	for id, number := range iterator {
+		require.Equal(t, uint64(len(actual)), id)
+
+		actual = append(actual, number)
+	}
+
+	require.Equal(
+		t,
+		expected,
+		actual,
+		"begin: %v, end: %v, step: %v, exclusive: %v",
+		begin,
+		end,
+		step,
+		exclusive,
+	)
+
+	size, err := strideSize(begin, end, step)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		uint64(len(expected)),
+		size,
+		"begin: %v, end: %v, step: %v, exclusive: %v",
+		begin,
+		end,
+		step,
+		exclusive,
+	)
+}
+
+func referenceStride[Type, TypeStep constraints.Integer](
+	begin Type,
+	end Type,
+	step TypeStep,
+	exclusive bool,
+) []Type {
+	reference := []Type(nil)
+
+	first := int(begin)
+	last := int(end)
+	stride := int(step)
+
+	if stride > 0 {
+		for number := first; number < last || number == last && !exclusive; number += stride {
+			reference = append(reference, Type(number))
+		}
+
+		return reference
+	}
+
+	for number := first; number > last || number == last && !exclusive; number += stride {
+		reference = append(reference, Type(number))
+	}
+
+	return reference
+}
+
+func TestStrideError(t *testing.T) {
+	_, err := Stride[int8](1, 2, 0)
+	require.ErrorIs(t, err, ErrStepZero)
+
+	_, err = StrideSize[int8](1, 2, 0)
+	require.ErrorIs(t, err, ErrStepZero)
+
+	_, err = StrideExclusive[int8](1, 2, 0)
+	require.ErrorIs(t, err, ErrStepZero)
+
+	_, err = StrideExclusiveSize[int8](1, 2, 0)
+	require.ErrorIs(t, err, ErrStepZero)
+}
+
+func TestStrideSizeMax(t *testing.T) {
+	size, err := StrideSize[int64](math.MinInt64, math.MaxInt64, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), size)
+
+	size, err = StrideSize[int64](math.MaxInt64, math.MinInt64, math.MinInt64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), size)
+
+	size, err = StrideExclusiveSize[int64](math.MinInt64, math.MaxInt64, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), size)
+
+	size, err = StrideExclusiveSize[uint64](math.MaxUint64, 0, -1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), size)
+}
+
+func BenchmarkStride(b *testing.B) {
+	number := 0
+
+	iterator, err := Stride(b.N, 1, -1)
+	require.NoError(b, err)
+
+	for _, value := range iterator {
+		number = value
+	}
+
+	require.NotZero(b, number)
+}
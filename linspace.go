@@ -0,0 +1,71 @@
+package safe
+
+import (
+	"iter"
+	"math/bits"
+
+	"golang.org/x/exp/constraints"
+)
+
+// An iterator over the specified number of evenly spaced integer values from begin
+// to end inclusive, like linspace in other languages. Used, for example, to obtain
+// bucket boundaries or sampling points.
+//
+// Value with index i is equal to begin+offset (begin-offset if begin is greater than
+// end), where offset is i*|end-begin|/(count-1) rounded to the nearest integer, and
+// halves are rounded away from begin. Calculation is performed without overflow for
+// any integer type, including ranges that span the whole type, so the first and last
+// values are exactly equal to begin and end.
+//
+// If count is greater than the number of integers between begin and end, then values
+// are repeated.
+//
+// If count is zero, then no one iteration of the loop will occur. If count is one,
+// then only begin is returned.
+//
+// In addition to the main integer, its index in the sequence is returned.
+func IntLinspace[Type constraints.Integer](begin, end Type, count uint64) iter.Seq2[uint64, Type] {
+	iterator := func(yield func(uint64, Type) bool) {
+		if count == 0 {
+			return
+		}
+
+		forward := begin <= end
+		distance := Dist(begin, end)
+		last := count - 1
+
+		if !yield(0, begin) {
+			return
+		}
+
+		for id := uint64(1); id <= last; id++ {
+			offset := mulDivRound(id, distance, last)
+
+			if !yield(id, shift(begin, offset, forward)) {
+				return
+			}
+		}
+	}
+
+	return iterator
+}
+
+// Calculates first*second/divisor rounded to the nearest integer with halves rounded
+// up. The first factor must not be greater than the divisor, so the result does not
+// exceed the second factor and is always representable.
+func mulDivRound(first, second, divisor uint64) uint64 {
+	high, low := bits.Mul64(first, second)
+
+	// High part of the product is less than the divisor, because the quotient does
+	// not exceed the second factor
+	quotient, remainder := bits.Div64(high, low, divisor)
+
+	// Comparison is performed in this form to avoid overflow when doubling the
+	// remainder. Quotient is less than the second factor if the remainder is not
+	// zero, so the increment does not overflow
+	if remainder != 0 && remainder >= divisor-remainder {
+		quotient++
+	}
+
+	return quotient
+}
@@ -0,0 +1,113 @@
+package safe
+
+import (
+	"math"
+	"testing"
+
+	"github.com/akramarenkov/intspec"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+func TestIntLinspace(t *testing.T) {
+	testIntLinspace[int8](t)
+	testIntLinspace[uint8](t)
+}
+
+func testIntLinspace[Type constraints.Integer](t *testing.T) {
+	counts := []uint64{0, 1, 2, 3, 4, 5, 7, 16, 100, math.MaxUint8 + 2}
+
+	for begin := range Iter(intspec.Range[Type]()) {
+		for end := range Iter(intspec.Range[Type]()) {
+			expected := []int64(nil)
+			actual := []int64(nil)
+
+			for _, count := range counts {
+				expected = append(expected, referenceIntLinspace(begin, end, count)...)
+
+				// Indices are included in the comparison as values
+				for id, value := range IntLinspace(begin, end, count) {
+					actual = append(actual, int64(id), int64(value))
+				}
+			}
+
+			require.Equal(t, expected, actual, "begin: %v, end: %v", begin, end)
+		}
+	}
+}
+
+func referenceIntLinspace[Type constraints.Integer](begin, end Type, count uint64) []int64 {
+	if count == 0 {
+		return nil
+	}
+
+	if count == 1 {
+		return []int64{0, int64(begin)}
+	}
+
+	last := int64(count) - 1
+	distance := int64(end) - int64(begin)
+	sign := int64(1)
+
+	if distance < 0 {
+		distance, sign = -distance, -1
+	}
+
+	values := make([]int64, 0, 2*count)
+
+	for id := range last + 1 {
+		// Rounding to the nearest integer with halves rounded away from begin
+		offset := (2*id*distance + last) / (2 * last)
+		values = append(values, id, int64(begin)+sign*offset)
+	}
+
+	return values
+}
+
+func TestIntLinspaceMax(t *testing.T) {
+	require.Equal(
+		t,
+		[]int64{math.MinInt64, 0, math.MaxInt64},
+		collectSeq2(IntLinspace[int64](math.MinInt64, math.MaxInt64, 3)),
+	)
+
+	require.Equal(
+		t,
+		[]int64{math.MaxInt64, -1, math.MinInt64},
+		collectSeq2(IntLinspace[int64](math.MaxInt64, math.MinInt64, 3)),
+	)
+
+	require.Equal(
+		t,
+		[]uint64{0, 1 << 62, 1 << 63, 3<<62 - 1, math.MaxUint64},
+		collectSeq2(IntLinspace[uint64](0, math.MaxUint64, 5)),
+	)
+
+	require.Equal(t, []uint64{0, 0, 1, 1}, collectSeq2(IntLinspace[uint64](0, 1, 4)))
+}
+
+func TestIntLinspaceBreak(t *testing.T) {
+	actual := []uint64(nil)
+
+	for id, value := range IntLinspace[uint64](0, math.MaxUint64, math.MaxUint64) {
+		if id == 3 {
+			break
+		}
+
+		actual = append(actual, value)
+	}
+
+	require.Equal(t, []uint64{0, 1, 2}, actual)
+}
+
+func BenchmarkIntLinspace(b *testing.B) {
+	value := int64(0)
+
+	for range b.N {
+		for _, number := range IntLinspace[int64](math.MinInt64, math.MaxInt64, 1000) {
+			value = number
+		}
+	}
+
+	require.NotZero(b, value)
+}
@@ -0,0 +1,294 @@
+package safe
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
+
+const (
+	listSeparator      = ","
+	listRangeSeparator = "-"
+)
+
+// List of integer ranges specified by an expression like "0-3,8,10-20:2". Used to
+// describe CPU lists, port ranges, shard selections and so on.
+//
+// Expression consists of comma-separated items, each of which is either a single
+// integer, or an inclusive range of integers "begin-end", or an inclusive range of
+// integers with a step "begin-end:step". Negative integers are allowed, for example,
+// "-10--5". Spaces around items are ignored.
+//
+// The zero value is an empty list.
+type List[Type constraints.Integer] struct {
+	ranges []Range[Type]
+}
+
+// Parses the list expression, for example, "0-3,8,10-20:2". Ranges of the list keep
+// the order in which they are specified in the expression.
+//
+// Empty expression corresponds to an empty list.
+//
+// In case of invalid syntax, values exceeding the type, reversed range (begin is
+// greater than end), zero or negative step, an error is returned.
+func ParseList[Type constraints.Integer](text string) (List[Type], error) {
+	if strings.TrimSpace(text) == "" {
+		return List[Type]{}, nil
+	}
+
+	items := strings.Split(text, listSeparator)
+
+	list := List[Type]{
+		ranges: make([]Range[Type], 0, len(items)),
+	}
+
+	for _, item := range items {
+		rng, err := parseListItem[Type](strings.TrimSpace(item))
+		if err != nil {
+			return List[Type]{}, err
+		}
+
+		list.ranges = append(list.ranges, rng)
+	}
+
+	return list, nil
+}
+
+func parseListItem[Type constraints.Integer](item string) (Range[Type], error) {
+	bounds, step, stepped := strings.Cut(item, rangeStepSeparator)
+
+	// Separator is searched for starting from the second character, because the
+	// first character can be the sign of a negative begin
+	separator := -1
+
+	if bounds != "" {
+		if index := strings.Index(bounds[1:], listRangeSeparator); index != -1 {
+			separator = index + 1
+		}
+	}
+
+	if separator == -1 {
+		if stepped {
+			return Range[Type]{}, ErrSyntaxInvalid
+		}
+
+		number, err := parseInt[Type](bounds)
+		if err != nil {
+			return Range[Type]{}, err
+		}
+
+		return Range[Type]{Begin: number, End: number}, nil
+	}
+
+	begin, err := parseInt[Type](bounds[:separator])
+	if err != nil {
+		return Range[Type]{}, err
+	}
+
+	end, err := parseInt[Type](bounds[separator+len(listRangeSeparator):])
+	if err != nil {
+		return Range[Type]{}, err
+	}
+
+	if begin > end {
+		return Range[Type]{}, ErrRangeReversed
+	}
+
+	rng := Range[Type]{
+		Begin: begin,
+		End:   end,
+	}
+
+	if !stepped {
+		return rng, nil
+	}
+
+	if rng.Step, err = parseInt[uint64](step); err != nil {
+		if strings.HasPrefix(step, "-") {
+			return Range[Type]{}, ErrStepNegative
+		}
+
+		return Range[Type]{}, err
+	}
+
+	if rng.Step == 0 {
+		return Range[Type]{}, ErrStepZero
+	}
+
+	return rng.canonical(), nil
+}
+
+// Returns the range in canonical form, i.e. with the zero step for ranges that
+// consist of consecutive integers.
+func (rng Range[Type]) canonical() Range[Type] {
+	if rng.Step == 1 || rng.Begin == rng.End {
+		rng.Step = 0
+	}
+
+	return rng
+}
+
+// Returns a copy of ranges of the list.
+func (lst List[Type]) Ranges() []Range[Type] {
+	return slices.Clone(lst.ranges)
+}
+
+// Returns a list in which ranges are sorted in ascending order and overlapping or
+// adjacent ranges of consecutive integers are merged. Ranges with a step are not
+// merged, since their union is generally not a range.
+func (lst List[Type]) Merge() List[Type] {
+	if len(lst.ranges) == 0 {
+		return List[Type]{}
+	}
+
+	sorted := slices.Clone(lst.ranges)
+	slices.SortFunc(sorted, compareRanges)
+
+	merged := List[Type]{
+		ranges: make([]Range[Type], 0, len(sorted)),
+	}
+
+	// Ranges of consecutive integers are merged separately so that ranges with a
+	// step located between them do not prevent merging
+	stepped := make([]Range[Type], 0, len(sorted))
+
+	for _, rng := range sorted {
+		if rng.Step != 0 {
+			stepped = append(stepped, rng)
+			continue
+		}
+
+		if len(merged.ranges) == 0 {
+			merged.ranges = append(merged.ranges, rng)
+			continue
+		}
+
+		last := &merged.ranges[len(merged.ranges)-1]
+
+		if !adjoins(last.End, rng.Begin) {
+			merged.ranges = append(merged.ranges, rng)
+			continue
+		}
+
+		last.End = max(last.End, rng.End)
+	}
+
+	merged.ranges = append(merged.ranges, stepped...)
+	slices.SortFunc(merged.ranges, compareRanges)
+
+	return merged
+}
+
+func compareRanges[Type constraints.Integer](first, second Range[Type]) int {
+	return cmp.Or(
+		cmp.Compare(first.Begin, second.Begin),
+		cmp.Compare(first.End, second.End),
+		cmp.Compare(first.Step, second.Step),
+	)
+}
+
+// Detects whether a number, that is not less than the end of a range, overlaps or
+// immediately follows it.
+func adjoins[Type constraints.Integer](end, number Type) bool {
+	// Number is greater than the end, so it is greater than the minimum value and
+	// there is no overflow when it is decreased by one
+	return number <= end || number-1 == end
+}
+
+// A range iterator for safely (without infinite loops due to counter overflow)
+// iterating over integer values of the list. Values are returned in the order of
+// ranges, without removing duplicates. See [Inc] and [IncStepE].
+func (lst List[Type]) All() iter.Seq[Type] {
+	iterator := func(yield func(Type) bool) {
+		for _, rng := range lst.ranges {
+			if rng.step() == 1 {
+				for number := range Inc(rng.Begin, rng.End) {
+					if !yield(number) {
+						return
+					}
+				}
+
+				continue
+			}
+
+			// Step is positive and begin is not greater than end, so there is no
+			// error
+			iterator, _ := IncStepE(rng.Begin, rng.End, rng.Step)
+
+			for _, number := range iterator {
+				if !yield(number) {
+					return
+				}
+			}
+		}
+	}
+
+	return iterator
+}
+
+// Calculates the number of iterations when using [List.All]. The return value is
+// intended to be used as the size parameter in the make call, so the return value is
+// truncated to the maximum value for uint64 if the calculated value exceeds it.
+func (lst List[Type]) Size() uint64 {
+	size := uint64(0)
+
+	for _, rng := range lst.ranges {
+		sum, err := AddU(size, rng.Len())
+		if err != nil {
+			return intspec.MaxUint64
+		}
+
+		size = sum
+	}
+
+	return size
+}
+
+// Returns the canonical expression of the list, for example, "0-3,8,10-20:2".
+func (lst List[Type]) String() string {
+	builder := strings.Builder{}
+
+	for id, rng := range lst.ranges {
+		if id != 0 {
+			builder.WriteString(listSeparator)
+		}
+
+		builder.WriteString(formatInt(rng.Begin))
+
+		if rng.Begin == rng.End {
+			continue
+		}
+
+		builder.WriteString(listRangeSeparator)
+		builder.WriteString(formatInt(rng.End))
+
+		if rng.step() != 1 {
+			builder.WriteString(rangeStepSeparator)
+			builder.WriteString(strconv.FormatUint(rng.Step, 10))
+		}
+	}
+
+	return builder.String()
+}
+
+// Implements the [encoding.TextMarshaler] interface.
+func (lst List[Type]) MarshalText() ([]byte, error) {
+	return []byte(lst.String()), nil
+}
+
+// Implements the [encoding.TextUnmarshaler] interface. See [ParseList].
+func (lst *List[Type]) UnmarshalText(text []byte) error {
+	parsed, err := ParseList[Type](string(text))
+	if err != nil {
+		return err
+	}
+
+	*lst = parsed
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package safe
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseList(t *testing.T) {
+	list, err := ParseList[int16]("1-5, 8,10-20:2,-10--5,-3-3:1,7-7:5")
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]Range[int16]{
+			{Begin: 1, End: 5},
+			{Begin: 8, End: 8},
+			{Begin: 10, End: 20, Step: 2},
+			{Begin: -10, End: -5},
+			{Begin: -3, End: 3},
+			{Begin: 7, End: 7},
+		},
+		list.Ranges(),
+	)
+	require.Equal(t, "1-5,8,10-20:2,-10--5,-3-3,7", list.String())
+	require.Equal(t, uint64(5+1+6+6+7+1), list.Size())
+
+	list, err = ParseList[int16]("")
+	require.NoError(t, err)
+	require.Empty(t, list.Ranges())
+	require.Empty(t, list.String())
+	require.Zero(t, list.Size())
+
+	list, err = ParseList[int16](" ")
+	require.NoError(t, err)
+	require.Empty(t, list.Ranges())
+}
+
+func TestParseListError(t *testing.T) {
+	_, err := ParseList[uint8]("1,,2")
+	require.ErrorIs(t, err, ErrSyntaxInvalid)
+
+	_, err = ParseList[uint8]("1-")
+	require.ErrorIs(t, err, ErrSyntaxInvalid)
+
+	_, err = ParseList[uint8]("-1")
+	require.ErrorIs(t, err, ErrSyntaxInvalid)
+
+	_, err = ParseList[uint8]("1-2-3")
+	require.ErrorIs(t, err, ErrSyntaxInvalid)
+
+	_, err = ParseList[uint8]("1:2")
+	require.ErrorIs(t, err, ErrSyntaxInvalid)
+
+	_, err = ParseList[uint8]("1-5:")
+	require.ErrorIs(t, err, ErrSyntaxInvalid)
+
+	_, err = ParseList[uint8]("1-256")
+	require.ErrorIs(t, err, ErrOverflow)
+
+	_, err = ParseList[int8]("-129-0")
+	require.ErrorIs(t, err, ErrOverflow)
+
+	_, err = ParseList[uint8]("1-5:18446744073709551616")
+	require.ErrorIs(t, err, ErrOverflow)
+
+	_, err = ParseList[uint8]("5-1")
+	require.ErrorIs(t, err, ErrRangeReversed)
+
+	_, err = ParseList[int8]("-1--5")
+	require.ErrorIs(t, err, ErrRangeReversed)
+
+	_, err = ParseList[uint8]("1-5:0")
+	require.ErrorIs(t, err, ErrStepZero)
+
+	_, err = ParseList[uint8]("1-5:-1")
+	require.ErrorIs(t, err, ErrStepNegative)
+}
+
+func TestListItems(t *testing.T) {
+	for _, step := range []uint64{1, 2, 3, math.MaxInt8, math.MaxUint8, math.MaxUint64} {
+		for begin := range Iter[int8](math.MinInt8, math.MaxInt8) {
+			for end := range Iter(begin, math.MaxInt8) {
+				testListItem(t, begin, end, step)
+			}
+		}
+
+		for begin := range Iter[uint8](0, math.MaxUint8) {
+			for end := range Iter(begin, math.MaxUint8) {
+				testListItem(t, begin, end, step)
+			}
+		}
+	}
+}
+
+func testListItem[Type int8 | uint8](t *testing.T, begin, end Type, step uint64) {
+	text := fmt.Sprintf("%d-%d:%d", begin, end, step)
+
+	list, err := ParseList[Type](text)
+	require.NoError(t, err, "text: %v", text)
+
+	expected := referenceRange(Range[Type]{Begin: begin, End: end, Step: step})
+	actual := slices.Collect(list.All())
+
+	require.Equal(t, expected, actual, "text: %v", text)
+	require.Equal(t, uint64(len(expected)), list.Size(), "text: %v", text)
+
+	parsed, err := ParseList[Type](list.String())
+	require.NoError(t, err, "text: %v", text)
+	require.Equal(t, list, parsed, "text: %v", text)
+}
+
+func TestListMerge(t *testing.T) {
+	list, err := ParseList[uint8]("20-30,0-3,4-6,8,1-2,10-20:2,9-12,255,254,250-253:3")
+	require.NoError(t, err)
+
+	merged := list.Merge()
+	require.Equal(t, "0-6,8-12,10-20:2,20-30,250-253:3,254-255", merged.String())
+	require.Equal(t, "20-30,0-3,4-6,8,1-2,10-20:2,9-12,255,254,250-253:3", list.String())
+
+	expected := slices.Sorted(list.All())
+	expected = slices.Compact(expected)
+
+	actual := slices.Sorted(merged.All())
+	actual = slices.Compact(actual)
+
+	require.Equal(t, expected, actual)
+
+	slist, err := ParseList[int8]("-128--100,-99,127,-10-126")
+	require.NoError(t, err)
+	require.Equal(t, "-128--99,-10-127", slist.Merge().String())
+
+	require.Empty(t, List[int8]{}.Merge().Ranges())
+}
+
+func TestListSizeMax(t *testing.T) {
+	list, err := ParseList[uint64]("0-18446744073709551615")
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), list.Size())
+
+	list, err = ParseList[uint64]("0-9223372036854775807,9223372036854775807-18446744073709551615")
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), list.Size())
+	require.Equal(t, "0-18446744073709551615", list.Merge().String())
+}
+
+func TestListPart(t *testing.T) {
+	list, err := ParseList[int8]("1-3,5-11:3")
+	require.NoError(t, err)
+
+	actual := []int8(nil)
+
+	for number := range list.All() {
+		if number > 5 {
+			break
+		}
+
+		actual = append(actual, number)
+	}
+
+	require.Equal(t, []int8{1, 2, 3, 5}, actual)
+
+	actual = nil
+
+	for number := range list.All() {
+		if number > 2 {
+			break
+		}
+
+		actual = append(actual, number)
+	}
+
+	require.Equal(t, []int8{1, 2}, actual)
+}
+
+func TestListText(t *testing.T) {
+	type config struct {
+		CPUs List[uint16] `json:"cpus"`
+	}
+
+	unmarshaled := config{}
+
+	require.NoError(t, json.Unmarshal([]byte(`{"cpus":"0-3, 8-11"}`), &unmarshaled))
+	require.Equal(t, []uint16{0, 1, 2, 3, 8, 9, 10, 11}, slices.Collect(unmarshaled.CPUs.All()))
+
+	data, err := json.Marshal(unmarshaled)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"cpus":"0-3,8-11"}`, string(data))
+
+	require.ErrorIs(t, json.Unmarshal([]byte(`{"cpus":"3-0"}`), &unmarshaled), ErrRangeReversed)
+}
+
+func BenchmarkParseList(b *testing.B) {
+	size := uint64(0)
+
+	for range b.N {
+		list, _ := ParseList[uint16]("0-3,8-11,1024-65535:2")
+		size = list.Size()
+	}
+
+	require.NotZero(b, size)
+}
@@ -0,0 +1,243 @@
+package safe
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/akramarenkov/safe/internal/is"
+	"github.com/akramarenkov/safe/internal/wide"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Number of integers in a piece of a slice processed by a worker at once.
+const parallelPieceSize = 1 << 16
+
+// Calculates the number of pieces of a slice of the specified length and the number
+// of workers that process them. If the specified number of workers is not positive,
+// then it is equal to GOMAXPROCS. The number of workers does not exceed the number of
+// pieces, but is at least one.
+func parallelism(length int, workers int) (int, int) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	pieces := (length + parallelPieceSize - 1) / parallelPieceSize
+
+	return pieces, max(min(workers, pieces), 1)
+}
+
+// Processes pieces of a slice of the specified length using the specified number of
+// workers.
+//
+// Pieces are taken by workers in ascending order, so when processing is stopped, all
+// pieces before the current one have been taken and will be processed completely.
+// Processing is stopped when the process function returns false.
+func parallelize(length int, workers int, process func(worker int, begin int, end int) bool) {
+	pieces, workers := parallelism(length, workers)
+
+	var (
+		next    atomic.Int64
+		stopped atomic.Bool
+	)
+
+	wg := sync.WaitGroup{}
+
+	for worker := range workers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for !stopped.Load() {
+				piece := int(next.Add(1) - 1)
+				if piece >= pieces {
+					return
+				}
+
+				begin := piece * parallelPieceSize
+				end := min(begin+parallelPieceSize, length)
+
+				if !process(worker, begin, end) {
+					stopped.Store(true)
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// Index of the first integer for which an error occurred, shared between workers.
+type firstFailure struct {
+	mutex sync.Mutex
+	index int
+	err   error
+}
+
+func (ff *firstFailure) store(index int, err error) {
+	ff.mutex.Lock()
+	defer ff.mutex.Unlock()
+
+	if ff.err == nil || index < ff.index {
+		ff.index = index
+		ff.err = err
+	}
+}
+
+func (ff *firstFailure) load() (int, error) {
+	ff.mutex.Lock()
+	defer ff.mutex.Unlock()
+
+	return ff.index, ff.err
+}
+
+// Adds up integers of a slice in parallel and detects whether an overflow has
+// occurred or not. The number of goroutines is equal to the number of workers, if the
+// number of workers is not positive, then it is equal to GOMAXPROCS.
+//
+// Result is the same as of the [AddM] function: integers are summed up in 128-bit
+// accumulators, so an error is returned only if the final sum does not fit into the
+// type. For unsigned types, summation is stopped as soon as the partial sum of one of
+// the workers exceeds the maximum value for the type.
+//
+// In case of overflow or missing arguments, an error is returned.
+func ParallelSum[Type constraints.Integer](numbers []Type, workers int) (Type, error) {
+	if len(numbers) == 0 {
+		return 0, ErrMissingArguments
+	}
+
+	_, workers = parallelism(len(numbers), workers)
+
+	// Each worker accesses only its own partial sum, so no synchronization is
+	// required
+	sums := make([]wide.Int, workers)
+	signed := is.Signed[Type]()
+
+	process := func(worker int, begin int, end int) bool {
+		sum := sums[worker]
+
+		for _, number := range numbers[begin:end] {
+			// Number of integers in a slice is limited by the amount of memory and
+			// is much less than 2^63, so the accumulator does not overflow
+			sum, _ = sum.Add(toWide(number))
+		}
+
+		sums[worker] = sum
+
+		if signed {
+			return true
+		}
+
+		// Partial sum of unsigned integers does not decrease
+		_, err := fromWide[Type](sum)
+
+		return err == nil
+	}
+
+	parallelize(len(numbers), workers, process)
+
+	total := wide.Int{}
+
+	for _, sum := range sums {
+		// Number of integers in a slice is much less than 2^63, so the sum of
+		// partial sums does not overflow
+		total, _ = total.Add(sum)
+	}
+
+	return fromWide[Type](total)
+}
+
+// Converts integers of a slice of one type to integers of another type in parallel,
+// stores them in the destination slice and detects whether an overflow has occurred
+// or not. The number of goroutines is equal to the number of workers, if the number
+// of workers is not positive, then it is equal to GOMAXPROCS.
+//
+// Result is the same as of the [ConvertSlice] function, except that integers of the
+// destination slice after the first overflow may be modified. Conversion is stopped
+// as soon as an overflow is found.
+//
+// In case of overflow, the index of the first integer for which it occurred and an
+// error are returned. In case of different lengths of slices, an error is returned.
+func ParallelConvert[TypeTo, TypeFrom constraints.Integer](
+	dst []TypeTo,
+	src []TypeFrom,
+	workers int,
+) (int, error) {
+	if err := checkLengths(len(dst), len(src)); err != nil {
+		return 0, err
+	}
+
+	failure := &firstFailure{}
+
+	process := func(_ int, begin int, end int) bool {
+		index, err := ConvertSlice(dst[begin:end], src[begin:end])
+		if err != nil {
+			failure.store(begin+index, err)
+			return false
+		}
+
+		return true
+	}
+
+	parallelize(len(src), workers, process)
+
+	return failure.load()
+}
+
+// Detects in parallel whether all integers of a slice can be converted to integers
+// of another type without overflow. The number of goroutines is equal to the number
+// of workers, if the number of workers is not positive, then it is equal to
+// GOMAXPROCS.
+//
+// Result is the same as of the sequential check of integers using the [IToI]
+// function. Check is stopped as soon as an overflow is found.
+//
+// In case of overflow, the index of the first integer for which it occurred and an
+// error are returned.
+func ParallelCheck[TypeTo, TypeFrom constraints.Integer](src []TypeFrom, workers int) (int, error) {
+	failure := &firstFailure{}
+
+	process := func(_ int, begin int, end int) bool {
+		index, err := checkConvert[TypeTo](src[begin:end])
+		if err != nil {
+			failure.store(begin+index, err)
+			return false
+		}
+
+		return true
+	}
+
+	parallelize(len(src), workers, process)
+
+	return failure.load()
+}
+
+// Detects whether all integers of a slice can be converted to integers of another
+// type without overflow.
+//
+// In case of overflow, the index of the first integer for which it occurred and an
+// error are returned.
+func checkConvert[TypeTo, TypeFrom constraints.Integer](src []TypeFrom) (int, error) {
+	var buffer [sliceBlockSize]TypeTo
+
+	op := convertSliceOp[TypeTo](src)
+
+	for begin := 0; begin < len(src); begin += sliceBlockSize {
+		end := min(begin+sliceBlockSize, len(src))
+
+		if !op.calc(begin, end, buffer[:end-begin]) {
+			continue
+		}
+
+		for id := begin; id < end; id++ {
+			if _, err := IToI[TypeTo](src[id]); err != nil {
+				return id, err
+			}
+		}
+	}
+
+	return 0, nil
+}
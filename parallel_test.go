@@ -0,0 +1,231 @@
+package safe
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Workers numbers used in tests, including non-positive ones that mean GOMAXPROCS.
+var testWorkers = []int{-1, 0, 1, 2, 3, 8, 100} //nolint:gochecknoglobals // Shared by tests
+
+func TestParallelSum(t *testing.T) {
+	numbers := make([]int64, 5*parallelPieceSize+123)
+
+	for id := range numbers {
+		numbers[id] = int64(id%1000) - 500
+	}
+
+	expected, err := AddM(numbers...)
+	require.NoError(t, err)
+
+	for _, workers := range testWorkers {
+		sum, err := ParallelSum(numbers, workers)
+		require.NoError(t, err)
+		require.Equal(t, expected, sum, "workers: %v", workers)
+	}
+
+	// Partial sums overflow, but the final sum fits
+	numbers[0] = math.MaxInt64
+	numbers[len(numbers)-1] = math.MaxInt64
+	numbers[parallelPieceSize] = math.MinInt64
+	numbers[2*parallelPieceSize] = math.MinInt64
+
+	expected, err = AddM(numbers...)
+	require.NoError(t, err)
+
+	for _, workers := range testWorkers {
+		sum, err := ParallelSum(numbers, workers)
+		require.NoError(t, err)
+		require.Equal(t, expected, sum, "workers: %v", workers)
+	}
+
+	numbers[2*parallelPieceSize] = math.MaxInt64
+
+	for _, workers := range testWorkers {
+		_, err := ParallelSum(numbers, workers)
+		require.ErrorIs(t, err, ErrOverflow, "workers: %v", workers)
+	}
+
+	_, err = ParallelSum([]int64{}, 0)
+	require.ErrorIs(t, err, ErrMissingArguments)
+}
+
+func TestParallelSumUns(t *testing.T) {
+	numbers := make([]uint32, 3*parallelPieceSize+7)
+
+	for id := range numbers {
+		numbers[id] = uint32(id % 100)
+	}
+
+	expected, err := AddMU(numbers...)
+	require.NoError(t, err)
+
+	for _, workers := range testWorkers {
+		sum, err := ParallelSum(numbers, workers)
+		require.NoError(t, err)
+		require.Equal(t, expected, sum, "workers: %v", workers)
+	}
+
+	numbers[2*parallelPieceSize+1] = math.MaxUint32
+
+	_, err = AddMU(numbers...)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	for _, workers := range testWorkers {
+		_, err := ParallelSum(numbers, workers)
+		require.ErrorIs(t, err, ErrOverflow, "workers: %v", workers)
+	}
+}
+
+func TestParallelConvert(t *testing.T) {
+	src := make([]int64, 4*parallelPieceSize+5)
+
+	for id := range src {
+		src[id] = int64(id % math.MaxInt16)
+	}
+
+	dst := make([]int16, len(src))
+
+	for _, workers := range testWorkers {
+		index, err := ParallelConvert(dst, src, workers)
+		require.NoError(t, err)
+		require.Zero(t, index)
+
+		for id, number := range src {
+			if int64(dst[id]) != number {
+				require.Equal(t, number, int64(dst[id]), "workers: %v, index: %v", workers, id)
+			}
+		}
+	}
+
+	failures := []int{len(src) - 1, 3*parallelPieceSize + 17, parallelPieceSize, 5, 0}
+
+	for _, failure := range failures {
+		src[failure] = math.MaxInt16 + 1
+
+		expected := make([]int16, len(src))
+
+		expectedIndex, expectedErr := ConvertSlice(expected, src)
+		require.ErrorIs(t, expectedErr, ErrOverflow)
+		require.Equal(t, failure, expectedIndex)
+
+		for _, workers := range testWorkers {
+			actual := make([]int16, len(src))
+
+			index, err := ParallelConvert(actual, src, workers)
+			require.ErrorIs(t, err, ErrOverflow)
+			require.Equal(t, expectedIndex, index, "workers: %v", workers)
+			require.Equal(t, expected[:index], actual[:index], "workers: %v", workers)
+
+			index, err = ParallelCheck[int16](src, workers)
+			require.ErrorIs(t, err, ErrOverflow)
+			require.Equal(t, expectedIndex, index, "workers: %v", workers)
+
+			index, err = ParallelCheck[int32](src, workers)
+			require.NoError(t, err)
+			require.Zero(t, index)
+		}
+	}
+
+	_, err := ParallelConvert(make([]int8, 1), make([]int16, 2), 0)
+	require.ErrorIs(t, err, ErrLengthMismatch)
+
+	index, err := ParallelConvert([]int8{}, []int16{}, 0)
+	require.NoError(t, err)
+	require.Zero(t, index)
+
+	index, err = ParallelCheck[int8]([]int16{}, 0)
+	require.NoError(t, err)
+	require.Zero(t, index)
+}
+
+func TestCheckConvert(t *testing.T) {
+	src := make([]int16, 0, 1<<16)
+
+	for number := range Inc[int16](math.MinInt16, math.MaxInt16) {
+		src = append(src, number)
+	}
+
+	for id := range src {
+		_, expected := IToI[int8](src[id])
+
+		index, err := checkConvert[int8](src[id : id+1])
+		require.Equal(t, expected, err)
+		require.Zero(t, index)
+	}
+
+	index, err := checkConvert[int8](src[-math.MinInt16+math.MinInt8:])
+	require.ErrorIs(t, err, ErrOverflow)
+	require.Equal(t, math.MaxInt8-math.MinInt8+1, index)
+
+	index, err = checkConvert[uint8](src)
+	require.ErrorIs(t, err, ErrOverflow)
+	require.Zero(t, index)
+}
+
+func benchmarkParallelNumbers() []int64 {
+	numbers := make([]int64, 1<<22)
+
+	for id := range numbers {
+		numbers[id] = int64(id % 1000)
+	}
+
+	return numbers
+}
+
+func BenchmarkRaceParallelSumReference(b *testing.B) {
+	numbers := benchmarkParallelNumbers()
+	sum := int64(0)
+
+	for range b.N {
+		sum, _ = AddM(numbers...)
+	}
+
+	require.NotZero(b, sum)
+}
+
+func BenchmarkRaceParallelSum(b *testing.B) {
+	numbers := benchmarkParallelNumbers()
+	sum := int64(0)
+
+	for range b.N {
+		sum, _ = ParallelSum(numbers, 0)
+	}
+
+	require.NotZero(b, sum)
+}
+
+func BenchmarkRaceParallelConvertReference(b *testing.B) {
+	src := benchmarkParallelNumbers()
+	dst := make([]int16, len(src))
+
+	for range b.N {
+		_, _ = ConvertSlice(dst, src)
+	}
+
+	require.NotZero(b, dst[len(dst)-1])
+}
+
+func BenchmarkRaceParallelConvert(b *testing.B) {
+	src := benchmarkParallelNumbers()
+	dst := make([]int16, len(src))
+
+	for range b.N {
+		_, _ = ParallelConvert(dst, src, 0)
+	}
+
+	require.NotZero(b, dst[len(dst)-1])
+}
+
+func BenchmarkRaceParallelCheck(b *testing.B) {
+	src := benchmarkParallelNumbers()
+	index := 0
+
+	for range b.N {
+		index, _ = ParallelCheck[int16](src, 0)
+	}
+
+	require.Zero(b, index)
+}
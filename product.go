@@ -0,0 +1,160 @@
+package safe
+
+import (
+	"iter"
+
+	"github.com/akramarenkov/safe/internal/clone"
+
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
+
+// State of a range used when iterating over the Cartesian product of ranges.
+type productRange[Type constraints.Integer] struct {
+	rng     Range[Type]
+	forward bool
+	last    uint64
+	current uint64
+}
+
+// A range iterator for safely (without infinite loops due to counter overflow)
+// iterating over the Cartesian product of ranges, i.e. over all combinations of
+// their values, as in nested loops. The last range corresponds to the most nested
+// loop.
+//
+// In addition to the combination, its linear index is returned. If the number of
+// combinations exceeds the maximum value for uint64, then the index is truncated to
+// the maximum value for uint64.
+//
+// The combination is returned in the same slice on each iteration, so it must be
+// copied if it is used after the iteration.
+//
+// If one of the ranges is empty, then no one iteration of the loop will occur. If no
+// ranges are specified, then the single empty combination is returned.
+func Product[Type constraints.Integer](ranges ...Range[Type]) iter.Seq2[uint64, []Type] {
+	// Zero index is less than the number of combinations for non-empty ranges, so
+	// an error is returned only if one of the ranges is empty
+	iterator, err := ProductFrom(0, ranges...)
+	if err != nil {
+		return emptyProduct[Type]
+	}
+
+	return iterator
+}
+
+// A range iterator like [Product], but that starts from the combination with the
+// specified linear index. Used to resume an interrupted iteration.
+//
+// In case of the index is not less than the number of combinations, an error is
+// returned.
+func ProductFrom[Type constraints.Integer](
+	index uint64,
+	ranges ...Range[Type],
+) (iter.Seq2[uint64, []Type], error) {
+	initial := make([]productRange[Type], len(ranges))
+
+	// Remaining part of the linear index is decomposed into indices in ranges,
+	// starting from the most nested one
+	remaining := index
+
+	for id := len(ranges) - 1; id >= 0; id-- {
+		forward, last, exists := ranges[id].bounds()
+		if !exists {
+			return nil, ErrIndexOutOfRange
+		}
+
+		initial[id] = productRange[Type]{
+			rng:     ranges[id],
+			forward: forward,
+			last:    last,
+			current: remaining,
+		}
+
+		// Number of values in the range is equal to 2^64 and is greater than any
+		// remaining part of the index
+		if last == intspec.MaxUint64 {
+			remaining = 0
+			continue
+		}
+
+		initial[id].current = remaining % (last + 1)
+		remaining /= last + 1
+	}
+
+	if remaining != 0 {
+		return nil, ErrIndexOutOfRange
+	}
+
+	iterator := func(yield func(uint64, []Type) bool) {
+		// States are modified during the iteration, so they are copied to allow
+		// the iterator to be used repeatedly and concurrently
+		states := clone.Slice(initial)
+		combination := make([]Type, len(states))
+
+		for id, state := range states {
+			combination[id] = state.rng.at(state.current, state.forward)
+		}
+
+		for linear := index; ; {
+			if !yield(linear, combination) {
+				return
+			}
+
+			if !nextCombination(states, combination) {
+				return
+			}
+
+			if linear != intspec.MaxUint64 {
+				linear++
+			}
+		}
+	}
+
+	return iterator, nil
+}
+
+// Advances the combination to the next one as in nested loops. If the combination is
+// the last one, then false is returned.
+func nextCombination[Type constraints.Integer](states []productRange[Type], combination []Type) bool {
+	for id := len(states) - 1; id >= 0; id-- {
+		state := &states[id]
+
+		if state.current < state.last {
+			state.current++
+			combination[id] = state.rng.at(state.current, state.forward)
+
+			return true
+		}
+
+		state.current = 0
+		combination[id] = state.rng.Begin
+	}
+
+	return false
+}
+
+func emptyProduct[Type constraints.Integer](func(uint64, []Type) bool) {}
+
+// Calculates the number of combinations when using [Product]. The return value is
+// intended to be used as the size parameter in the make call, so the return value is
+// truncated to the maximum value for uint64 if the calculated value exceeds it.
+func ProductSize[Type constraints.Integer](ranges ...Range[Type]) uint64 {
+	for _, rng := range ranges {
+		if rng.Len() == 0 {
+			return 0
+		}
+	}
+
+	size := uint64(1)
+
+	for _, rng := range ranges {
+		product, err := MulU(size, rng.Len())
+		if err != nil {
+			return intspec.MaxUint64
+		}
+
+		size = product
+	}
+
+	return size
+}
@@ -0,0 +1,217 @@
+package safe
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProduct(t *testing.T) {
+	ranges := []Range[int8]{
+		{Begin: math.MinInt8, End: math.MaxInt8, Step: 100},
+		{Begin: 2, End: -2, Exclusive: true},
+		{Begin: math.MaxInt8, End: math.MaxInt8},
+		{Begin: math.MaxInt8 - 1, End: math.MaxInt8},
+	}
+
+	testProduct(t, ranges)
+	testProduct(t, ranges[:1])
+	testProduct(t, ranges[1:])
+	testProduct[int8](t, nil)
+
+	rangesU := []Range[uint8]{
+		{Begin: 0, End: math.MaxUint8},
+		{Begin: math.MaxUint8, End: 0, Step: 127},
+	}
+
+	testProduct(t, rangesU)
+}
+
+func testProduct[Type int8 | uint8](t *testing.T, ranges []Range[Type]) {
+	reference := [][]Type{{}}
+
+	for _, rng := range ranges {
+		expanded := [][]Type(nil)
+
+		for _, combination := range reference {
+			for _, number := range referenceRange(rng) {
+				expanded = append(expanded, append(slices.Clone(combination), number))
+			}
+		}
+
+		reference = expanded
+	}
+
+	require.Equal(t, uint64(len(reference)), ProductSize(ranges...))
+
+	for index := range Iter(0, len(reference)) {
+		iterator, err := ProductFrom(uint64(index), ranges...)
+
+		if index == len(reference) {
+			require.ErrorIs(t, err, ErrIndexOutOfRange)
+			require.Nil(t, iterator)
+
+			continue
+		}
+
+		require.NoError(t, err)
+
+		actual := [][]Type(nil)
+
+		for id, combination := range iterator {
+			require.Equal(t, uint64(index+len(actual)), id)
+
+			actual = append(actual, slices.Clone(combination))
+		}
+
+		require.Equal(t, reference[index:], actual, "ranges: %v, index: %v", ranges, index)
+	}
+
+	actual := [][]Type(nil)
+
+	for _, combination := range Product(ranges...) {
+		actual = append(actual, slices.Clone(combination))
+	}
+
+	require.Equal(t, reference, actual, "ranges: %v", ranges)
+}
+
+func TestProductEmpty(t *testing.T) {
+	ranges := []Range[int8]{
+		{Begin: 1, End: 2},
+		{Begin: 1, End: 1, Exclusive: true},
+	}
+
+	require.Zero(t, ProductSize(ranges...))
+
+	for range Product(ranges...) {
+		require.FailNow(t, "must not be called")
+	}
+
+	_, err := ProductFrom(0, ranges...)
+	require.ErrorIs(t, err, ErrIndexOutOfRange)
+}
+
+func TestProductPart(t *testing.T) {
+	ranges := []Range[int8]{
+		{Begin: 0, End: 9},
+		{Begin: 0, End: 9},
+	}
+
+	last := []int8(nil)
+	index := uint64(0)
+
+	for id, combination := range Product(ranges...) {
+		if id == 42 {
+			last = slices.Clone(combination)
+			index = id
+
+			break
+		}
+	}
+
+	require.Equal(t, []int8{4, 2}, last)
+
+	iterator, err := ProductFrom(index, ranges...)
+	require.NoError(t, err)
+
+	for id, combination := range iterator {
+		require.Equal(t, index, id)
+		require.Equal(t, last, combination)
+
+		break
+	}
+}
+
+func TestProductRepeated(t *testing.T) {
+	iterator := Product(Range[int8]{Begin: 0, End: 2}, Range[int8]{Begin: 0, End: 2})
+
+	for id := range iterator {
+		if id == 3 {
+			break
+		}
+	}
+
+	collected := [][]int8(nil)
+
+	for id, combination := range iterator {
+		require.Equal(t, uint64(len(collected)), id)
+
+		collected = append(collected, slices.Clone(combination))
+	}
+
+	require.Len(t, collected, 9)
+	require.Equal(t, []int8{0, 0}, collected[0])
+	require.Equal(t, []int8{2, 2}, collected[8])
+}
+
+func TestProductMax(t *testing.T) {
+	ranges := []Range[uint64]{
+		{Begin: 0, End: 1},
+		{Begin: 0, End: math.MaxUint64},
+	}
+
+	require.Equal(t, uint64(math.MaxUint64), ProductSize(ranges...))
+	require.Equal(t, uint64(math.MaxUint64), ProductSize(ranges[1:]...))
+
+	iterator, err := ProductFrom(math.MaxUint64, ranges...)
+	require.NoError(t, err)
+
+	actual := [][]uint64(nil)
+	ids := []uint64(nil)
+
+	for id, combination := range iterator {
+		if len(actual) == 3 {
+			break
+		}
+
+		actual = append(actual, slices.Clone(combination))
+		ids = append(ids, id)
+	}
+
+	require.Equal(t, [][]uint64{{0, math.MaxUint64}, {1, 0}, {1, 1}}, actual)
+	require.Equal(t, []uint64{math.MaxUint64, math.MaxUint64, math.MaxUint64}, ids)
+
+	sranges := []Range[int64]{
+		{Begin: math.MinInt64, End: math.MaxInt64, Step: math.MaxInt64},
+		{Begin: math.MaxInt64, End: math.MinInt64, Step: math.MaxUint64},
+	}
+
+	require.Equal(t, uint64(6), ProductSize(sranges...))
+
+	sactual := [][]int64(nil)
+
+	for _, combination := range Product(sranges...) {
+		sactual = append(sactual, slices.Clone(combination))
+	}
+
+	require.Equal(
+		t,
+		[][]int64{
+			{math.MinInt64, math.MaxInt64},
+			{math.MinInt64, math.MinInt64},
+			{-1, math.MaxInt64},
+			{-1, math.MinInt64},
+			{math.MaxInt64 - 1, math.MaxInt64},
+			{math.MaxInt64 - 1, math.MinInt64},
+		},
+		sactual,
+	)
+}
+
+func BenchmarkProduct(b *testing.B) {
+	ranges := []Range[int]{
+		{Begin: 1, End: b.N},
+		{Begin: 1, End: 1},
+	}
+
+	number := 0
+
+	for _, combination := range Product(ranges...) {
+		number = combination[0]
+	}
+
+	require.NotZero(b, number)
+}
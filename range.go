@@ -0,0 +1,300 @@
+package safe
+
+import (
+	"errors"
+	"iter"
+	"strconv"
+	"strings"
+
+	"github.com/akramarenkov/safe/internal/is"
+
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
+
+const (
+	rangeSeparator          = ".."
+	rangeExclusiveSeparator = "..<"
+	rangeStepSeparator      = ":"
+)
+
+// Range of integer values from Begin to End with a step. Can be used as a reusable
+// description of an iteration, for example, loaded from a configuration file.
+//
+// If Begin is greater than End, the values are decremented, otherwise they are
+// incremented.
+//
+// As in a regular loop, if the Begin-End range is not a multiple of the step, the End
+// value is not included.
+//
+// Text form of the range is "begin..end" for inclusive ranges and "begin..<end" for
+// exclusive ranges, optionally followed by ":step", for example, "0..255:5".
+//
+// The zero value is a range containing only zero.
+type Range[Type constraints.Integer] struct {
+	// First value of the range
+	Begin Type
+	// Last value of the range if it is not excluded and the Begin-End range is a
+	// multiple of the step
+	End Type
+	// Step of the range. If zero, a step of one is used
+	Step uint64
+	// If true, the End value is excluded from the range
+	Exclusive bool
+}
+
+// Returns the step of the range taking into account its default value.
+func (rng Range[Type]) step() uint64 {
+	if rng.Step == 0 {
+		return 1
+	}
+
+	return rng.Step
+}
+
+// Returns whether the range is incremented and the index of its last value. If the
+// range is empty, then false is returned as the last return value.
+func (rng Range[Type]) bounds() (bool, uint64, bool) {
+	forward := rng.Begin <= rng.End
+
+	if rng.Exclusive && rng.Begin == rng.End {
+		return forward, 0, false
+	}
+
+	distance := Dist(rng.Begin, rng.End)
+
+	if rng.Exclusive {
+		distance--
+	}
+
+	return forward, distance / rng.step(), true
+}
+
+// Returns the value of the range by its index. The index must not exceed the index
+// of the last value of the range.
+func (rng Range[Type]) at(index uint64, forward bool) Type {
+	// Offset does not exceed the distance between Begin and End, so there is no
+	// overflow of uint64
+	return shift(rng.Begin, index*rng.step(), forward)
+}
+
+// A range iterator for safely (without infinite loops due to counter overflow)
+// iterating over integer values of the range.
+//
+// In addition to the main integer, its index in the range is returned.
+func (rng Range[Type]) All() iter.Seq2[uint64, Type] {
+	forward, last, exists := rng.bounds()
+	if !exists {
+		return emptySeq2[Type]
+	}
+
+	return stepIter(rng.Begin, rng.at(last, forward), rng.step(), forward)
+}
+
+// A range iterator for safely (without infinite loops due to counter overflow)
+// iterating over integer values of the range in reverse order.
+//
+// In addition to the main integer, its index in the range is returned, i.e. indices
+// are returned in descending order.
+func (rng Range[Type]) Backward() iter.Seq2[uint64, Type] {
+	iterator := func(yield func(uint64, Type) bool) {
+		forward, last, exists := rng.bounds()
+		if !exists {
+			return
+		}
+
+		for id, number := range stepIter(rng.at(last, forward), rng.Begin, rng.step(), !forward) {
+			if !yield(last-id, number) {
+				return
+			}
+		}
+	}
+
+	return iterator
+}
+
+// Calculates the number of values in the range. The return value is intended to be
+// used as the size parameter in the make call, so, and because the maximum possible
+// number of values is one more than the maximum value for uint64, the return value
+// is truncated to the maximum value for uint64 if the calculated value exceeds it.
+func (rng Range[Type]) Len() uint64 {
+	_, last, exists := rng.bounds()
+	if !exists {
+		return 0
+	}
+
+	if last == intspec.MaxUint64 {
+		return last
+	}
+
+	// +1 due to the presence of the value with index zero
+	return last + 1
+}
+
+// Returns the value of the range by its index.
+//
+// In case of the index is out of the range, an error is returned.
+func (rng Range[Type]) At(index uint64) (Type, error) {
+	forward, last, exists := rng.bounds()
+	if !exists || index > last {
+		return 0, ErrIndexOutOfRange
+	}
+
+	return rng.at(index, forward), nil
+}
+
+// Detects whether the range contains a number or not.
+func (rng Range[Type]) Contains(number Type) bool {
+	_, exists := rng.IndexOf(number)
+	return exists
+}
+
+// Returns the index of a number in the range. If the range does not contain the
+// number, then false is returned.
+func (rng Range[Type]) IndexOf(number Type) (uint64, bool) {
+	forward, last, exists := rng.bounds()
+	if !exists {
+		return 0, false
+	}
+
+	if forward && number < rng.Begin || !forward && number > rng.Begin {
+		return 0, false
+	}
+
+	distance := Dist(rng.Begin, number)
+
+	if distance%rng.step() != 0 {
+		return 0, false
+	}
+
+	if index := distance / rng.step(); index <= last {
+		return index, true
+	}
+
+	return 0, false
+}
+
+// Returns the text form of the range, for example, "0..255:5".
+func (rng Range[Type]) String() string {
+	builder := strings.Builder{}
+
+	builder.WriteString(formatInt(rng.Begin))
+
+	if rng.Exclusive {
+		builder.WriteString(rangeExclusiveSeparator)
+	} else {
+		builder.WriteString(rangeSeparator)
+	}
+
+	builder.WriteString(formatInt(rng.End))
+
+	if rng.step() != 1 {
+		builder.WriteString(rangeStepSeparator)
+		builder.WriteString(strconv.FormatUint(rng.Step, 10))
+	}
+
+	return builder.String()
+}
+
+// Implements the [encoding.TextMarshaler] interface.
+func (rng Range[Type]) MarshalText() ([]byte, error) {
+	return []byte(rng.String()), nil
+}
+
+// Implements the [encoding.TextUnmarshaler] interface. See [ParseRange].
+func (rng *Range[Type]) UnmarshalText(text []byte) error {
+	parsed, err := ParseRange[Type](string(text))
+	if err != nil {
+		return err
+	}
+
+	*rng = parsed
+
+	return nil
+}
+
+// Parses the text form of the range, for example, "0..255:5" or "-10..<10".
+//
+// In case of invalid syntax, values exceeding the type or zero step, an error is
+// returned.
+func ParseRange[Type constraints.Integer](text string) (Range[Type], error) {
+	bounds, step, stepped := strings.Cut(text, rangeStepSeparator)
+
+	begin, end, found := strings.Cut(bounds, rangeSeparator)
+	if !found {
+		return Range[Type]{}, ErrSyntaxInvalid
+	}
+
+	rng := Range[Type]{}
+
+	if strings.HasPrefix(end, "<") {
+		end = end[1:]
+		rng.Exclusive = true
+	}
+
+	var err error
+
+	if rng.Begin, err = parseInt[Type](begin); err != nil {
+		return Range[Type]{}, err
+	}
+
+	if rng.End, err = parseInt[Type](end); err != nil {
+		return Range[Type]{}, err
+	}
+
+	if !stepped {
+		return rng, nil
+	}
+
+	if rng.Step, err = parseInt[uint64](step); err != nil {
+		if strings.HasPrefix(step, "-") {
+			return Range[Type]{}, ErrStepNegative
+		}
+
+		return Range[Type]{}, err
+	}
+
+	if rng.Step == 0 {
+		return Range[Type]{}, ErrStepZero
+	}
+
+	return rng, nil
+}
+
+// Parses a decimal integer of the specified type.
+//
+// In case of invalid syntax or value exceeding the type, an error is returned.
+func parseInt[Type constraints.Integer](text string) (Type, error) {
+	if is.Signed[Type]() {
+		number, err := strconv.ParseInt(text, 10, intspec.BitSize[Type]())
+		if err != nil {
+			return 0, convertParseError(err)
+		}
+
+		return Type(number), nil
+	}
+
+	number, err := strconv.ParseUint(text, 10, intspec.BitSize[Type]())
+	if err != nil {
+		return 0, convertParseError(err)
+	}
+
+	return Type(number), nil
+}
+
+func convertParseError(err error) error {
+	if errors.Is(err, strconv.ErrRange) {
+		return ErrOverflow
+	}
+
+	return ErrSyntaxInvalid
+}
+
+// Formats an integer in decimal form.
+func formatInt[Type constraints.Integer](number Type) string {
+	if is.Signed[Type]() {
+		return strconv.FormatInt(int64(number), 10)
+	}
+
+	return strconv.FormatUint(uint64(number), 10)
+}
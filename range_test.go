@@ -0,0 +1,236 @@
+package safe
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/akramarenkov/intspec"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+func TestRange(t *testing.T) {
+	bounds := []int8{math.MinInt8, math.MinInt8 + 1, -3, -1, 0, 1, 5, math.MaxInt8 - 1, math.MaxInt8}
+	boundsU := []uint8{0, 1, 5, math.MaxUint8 - 1, math.MaxUint8}
+	steps := []uint64{0, 1, 2, 3, 7, math.MaxInt8, math.MaxInt8 + 1, math.MaxUint8, math.MaxUint8 + 1, math.MaxUint64}
+
+	for _, step := range steps {
+		for _, exclusive := range []bool{false, true} {
+			for _, begin := range bounds {
+				for _, end := range bounds {
+					testRange(t, Range[int8]{Begin: begin, End: end, Step: step, Exclusive: exclusive})
+				}
+			}
+
+			for _, begin := range boundsU {
+				for _, end := range boundsU {
+					testRange(t, Range[uint8]{Begin: begin, End: end, Step: step, Exclusive: exclusive})
+				}
+			}
+		}
+	}
+}
+
+func testRange[Type constraints.Integer](t *testing.T, rng Range[Type]) {
+	expected := referenceRange(rng)
+
+	actual := []Type(nil)
+
+	for id, number := range rng.All() {
+		require.Equal(t, uint64(len(actual)), id, "range: %v", rng)
+
+		actual = append(actual, number)
+	}
+
+	require.Equal(t, expected, actual, "range: %v", rng)
+	require.Equal(t, uint64(len(expected)), rng.Len(), "range: %v", rng)
+
+	backward := []Type(nil)
+
+	for id, number := range rng.Backward() {
+		require.Equal(t, uint64(len(expected)-len(backward)-1), id, "range: %v", rng)
+
+		backward = append(backward, number)
+	}
+
+	slices.Reverse(backward)
+	require.Equal(t, expected, backward, "range: %v", rng)
+
+	for id, number := range expected {
+		value, err := rng.At(uint64(id))
+		require.NoError(t, err, "range: %v", rng)
+		require.Equal(t, number, value, "range: %v", rng)
+	}
+
+	_, err := rng.At(uint64(len(expected)))
+	require.ErrorIs(t, err, ErrIndexOutOfRange, "range: %v", rng)
+
+	for number := range Iter(intspec.Range[Type]()) {
+		index, exists := rng.IndexOf(number)
+		require.Equal(t, rng.Contains(number), exists, "range: %v", rng)
+
+		reference := slices.Index(expected, number)
+
+		if reference == -1 {
+			require.False(t, exists, "range: %v, number: %v", rng, number)
+			continue
+		}
+
+		require.True(t, exists, "range: %v, number: %v", rng, number)
+		require.Equal(t, uint64(reference), index, "range: %v, number: %v", rng, number)
+	}
+
+	parsed, err := ParseRange[Type](rng.String())
+	require.NoError(t, err, "range: %v", rng)
+
+	if rng.Step == 1 {
+		parsed.Step = 1
+	}
+
+	require.Equal(t, rng, parsed)
+}
+
+func referenceRange[Type constraints.Integer](rng Range[Type]) []Type {
+	reference := []Type(nil)
+
+	begin := int(rng.Begin)
+	end := int(rng.End)
+	step := min(rng.Step, math.MaxUint16)
+
+	if step == 0 {
+		step = 1
+	}
+
+	if begin <= end {
+		for number := begin; number < end || number == end && !rng.Exclusive; number += int(step) {
+			reference = append(reference, Type(number))
+		}
+
+		return reference
+	}
+
+	for number := begin; number > end || number == end && !rng.Exclusive; number -= int(step) {
+		reference = append(reference, Type(number))
+	}
+
+	return reference
+}
+
+func TestRangeMax(t *testing.T) {
+	rng := Range[uint64]{Begin: 0, End: math.MaxUint64}
+	require.Equal(t, uint64(math.MaxUint64), rng.Len())
+
+	value, err := rng.At(math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), value)
+
+	rng.Exclusive = true
+	require.Equal(t, uint64(math.MaxUint64), rng.Len())
+
+	_, err = rng.At(math.MaxUint64)
+	require.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	rng = Range[uint64]{Begin: math.MaxUint64, End: 0, Step: math.MaxUint64}
+	require.Equal(t, uint64(2), rng.Len())
+
+	value, err = rng.At(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), value)
+
+	index, exists := rng.IndexOf(0)
+	require.True(t, exists)
+	require.Equal(t, uint64(1), index)
+
+	srng := Range[int64]{Begin: math.MinInt64, End: math.MaxInt64, Step: math.MaxUint64 / 2}
+	require.Equal(t, uint64(3), srng.Len())
+
+	svalue, err := srng.At(2)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MaxInt64-1), svalue)
+}
+
+func TestParseRange(t *testing.T) {
+	rng, err := ParseRange[int8]("-128..<127:5")
+	require.NoError(t, err)
+	require.Equal(t, Range[int8]{Begin: math.MinInt8, End: math.MaxInt8, Step: 5, Exclusive: true}, rng)
+	require.Equal(t, "-128..<127:5", rng.String())
+
+	rng, err = ParseRange[int8]("10..-10")
+	require.NoError(t, err)
+	require.Equal(t, Range[int8]{Begin: 10, End: -10}, rng)
+	require.Equal(t, "10..-10", rng.String())
+
+	_, err = ParseRange[int8]("10")
+	require.ErrorIs(t, err, ErrSyntaxInvalid)
+
+	_, err = ParseRange[int8]("10...20")
+	require.ErrorIs(t, err, ErrSyntaxInvalid)
+
+	_, err = ParseRange[int8]("a..20")
+	require.ErrorIs(t, err, ErrSyntaxInvalid)
+
+	_, err = ParseRange[int8]("..20")
+	require.ErrorIs(t, err, ErrSyntaxInvalid)
+
+	_, err = ParseRange[int8]("0..20:")
+	require.ErrorIs(t, err, ErrSyntaxInvalid)
+
+	_, err = ParseRange[int8]("0..20:1:2")
+	require.ErrorIs(t, err, ErrSyntaxInvalid)
+
+	_, err = ParseRange[int8]("0..128")
+	require.ErrorIs(t, err, ErrOverflow)
+
+	_, err = ParseRange[uint8]("-1..20")
+	require.ErrorIs(t, err, ErrSyntaxInvalid)
+
+	_, err = ParseRange[uint8]("0..20:18446744073709551616")
+	require.ErrorIs(t, err, ErrOverflow)
+
+	_, err = ParseRange[int8]("0..20:0")
+	require.ErrorIs(t, err, ErrStepZero)
+
+	_, err = ParseRange[int8]("0..20:-1")
+	require.ErrorIs(t, err, ErrStepNegative)
+}
+
+func TestRangeText(t *testing.T) {
+	type config struct {
+		Ports Range[uint16] `json:"ports"`
+	}
+
+	data, err := json.Marshal(config{Ports: Range[uint16]{Begin: 8000, End: 9000, Step: 10}})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"ports":"8000..9000:10"}`, string(data))
+
+	unmarshaled := config{}
+
+	require.NoError(t, json.Unmarshal([]byte(`{"ports":"100..<200"}`), &unmarshaled))
+	require.Equal(t, Range[uint16]{Begin: 100, End: 200, Exclusive: true}, unmarshaled.Ports)
+
+	require.ErrorIs(t, json.Unmarshal([]byte(`{"ports":"100..65536"}`), &unmarshaled), ErrOverflow)
+}
+
+func BenchmarkRange(b *testing.B) {
+	number := 0
+
+	for _, value := range (Range[int]{Begin: b.N, End: 1}).All() {
+		number = value
+	}
+
+	require.NotZero(b, number)
+}
+
+func BenchmarkRangeAt(b *testing.B) {
+	rng := Range[int]{Begin: 1, End: b.N, Step: 3}
+
+	number := 0
+
+	for id := range b.N {
+		number, _ = rng.At(uint64(id) % rng.Len())
+	}
+
+	require.NotZero(b, number)
+}
@@ -0,0 +1,232 @@
+package safe
+
+import (
+	"iter"
+	"slices"
+	"sort"
+
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
+
+// Set of integers stored as sorted disjoint intervals. Used to maintain allocated
+// port ranges, free block lists, VLAN IDs and so on.
+//
+// Intervals of the set neither overlap nor adjoin each other, i.e. overlapping or
+// adjacent intervals are merged when added. Boundary arithmetic is checked, so the
+// set works correctly up to the minimum and maximum values for the type.
+//
+// The zero value is an empty set.
+type RangeSet[Type constraints.Integer] struct {
+	intervals []Interval[Type]
+}
+
+// Creates a set containing the specified intervals.
+func NewRangeSet[Type constraints.Integer](intervals ...Interval[Type]) *RangeSet[Type] {
+	set := &RangeSet[Type]{}
+
+	for _, ivl := range intervals {
+		set.Add(ivl)
+	}
+
+	return set
+}
+
+// Detects whether the second interval, whose lower bound is not less than the lower
+// bound of the first interval, overlaps or immediately follows the first interval.
+func touches[Type constraints.Integer](first, second Interval[Type]) bool {
+	if second.lower <= first.upper {
+		return true
+	}
+
+	next, err := Add(first.upper, 1)
+
+	return err == nil && next == second.lower
+}
+
+// Adds all integers of the interval to the set.
+func (set *RangeSet[Type]) Add(ivl Interval[Type]) {
+	// First interval of the set that overlaps or adjoins the added one
+	begin := sort.Search(len(set.intervals), func(id int) bool {
+		return touches(set.intervals[id], ivl)
+	})
+
+	// First interval of the set that is located after the added one and does not
+	// adjoin it
+	end := sort.Search(len(set.intervals), func(id int) bool {
+		return set.intervals[id].lower > ivl.upper && !touches(ivl, set.intervals[id])
+	})
+
+	if begin < end {
+		ivl.lower = min(ivl.lower, set.intervals[begin].lower)
+		ivl.upper = max(ivl.upper, set.intervals[end-1].upper)
+	}
+
+	set.intervals = slices.Replace(set.intervals, begin, end, ivl)
+}
+
+// Removes all integers of the interval from the set.
+func (set *RangeSet[Type]) Remove(ivl Interval[Type]) {
+	// First interval of the set that overlaps the removed one
+	begin := sort.Search(len(set.intervals), func(id int) bool {
+		return set.intervals[id].upper >= ivl.lower
+	})
+
+	// First interval of the set that is located after the removed one
+	end := sort.Search(len(set.intervals), func(id int) bool {
+		return set.intervals[id].lower > ivl.upper
+	})
+
+	if begin == end {
+		return
+	}
+
+	remains := make([]Interval[Type], 0, 2)
+
+	if previous, err := Sub(ivl.lower, 1); err == nil && set.intervals[begin].lower <= previous {
+		remains = append(remains, Interval[Type]{lower: set.intervals[begin].lower, upper: previous})
+	}
+
+	if next, err := Add(ivl.upper, 1); err == nil && set.intervals[end-1].upper >= next {
+		remains = append(remains, Interval[Type]{lower: next, upper: set.intervals[end-1].upper})
+	}
+
+	set.intervals = slices.Replace(set.intervals, begin, end, remains...)
+}
+
+// Detects whether the set contains a number or not.
+func (set *RangeSet[Type]) Contains(number Type) bool {
+	id := sort.Search(len(set.intervals), func(id int) bool {
+		return set.intervals[id].upper >= number
+	})
+
+	return id < len(set.intervals) && set.intervals[id].lower <= number
+}
+
+// Returns a set containing integers that are contained in at least one of the sets.
+func (set *RangeSet[Type]) Union(other *RangeSet[Type]) *RangeSet[Type] {
+	union := &RangeSet[Type]{
+		intervals: make([]Interval[Type], 0, len(set.intervals)+len(other.intervals)),
+	}
+
+	first, second := set.intervals, other.intervals
+
+	for len(first) != 0 || len(second) != 0 {
+		var ivl Interval[Type]
+
+		if len(second) == 0 || len(first) != 0 && first[0].lower <= second[0].lower {
+			ivl, first = first[0], first[1:]
+		} else {
+			ivl, second = second[0], second[1:]
+		}
+
+		if len(union.intervals) == 0 {
+			union.intervals = append(union.intervals, ivl)
+			continue
+		}
+
+		last := &union.intervals[len(union.intervals)-1]
+
+		if !touches(*last, ivl) {
+			union.intervals = append(union.intervals, ivl)
+			continue
+		}
+
+		last.upper = max(last.upper, ivl.upper)
+	}
+
+	return union
+}
+
+// Returns a set containing integers that are contained in both sets.
+func (set *RangeSet[Type]) Intersect(other *RangeSet[Type]) *RangeSet[Type] {
+	intersection := &RangeSet[Type]{}
+
+	first, second := set.intervals, other.intervals
+
+	for len(first) != 0 && len(second) != 0 {
+		if ivl, intersect := first[0].Intersect(second[0]); intersect {
+			intersection.intervals = append(intersection.intervals, ivl)
+		}
+
+		if first[0].upper < second[0].upper {
+			first = first[1:]
+		} else {
+			second = second[1:]
+		}
+	}
+
+	return intersection
+}
+
+// Returns a set containing integers that are contained in the set but not in the
+// other set.
+func (set *RangeSet[Type]) Difference(other *RangeSet[Type]) *RangeSet[Type] {
+	return set.Intersect(other.Complement())
+}
+
+// Returns a set containing integers of the type that are not contained in the set.
+func (set *RangeSet[Type]) Complement() *RangeSet[Type] {
+	complement := &RangeSet[Type]{
+		intervals: make([]Interval[Type], 0, len(set.intervals)+1),
+	}
+
+	lower, upper := intspec.Range[Type]()
+
+	for _, ivl := range set.intervals {
+		if previous, err := Sub(ivl.lower, 1); err == nil && previous >= lower {
+			complement.intervals = append(complement.intervals, Interval[Type]{lower: lower, upper: previous})
+		}
+
+		next, err := Add(ivl.upper, 1)
+		if err != nil {
+			return complement
+		}
+
+		lower = next
+	}
+
+	complement.intervals = append(complement.intervals, Interval[Type]{lower: lower, upper: upper})
+
+	return complement
+}
+
+// Calculates the number of integers in the set. The return value is intended to be
+// used as the size parameter in the make call, so, and because the maximum possible
+// number of integers is one more than the maximum value for uint64, the return value
+// is truncated to the maximum value for uint64 if the calculated value exceeds it.
+func (set *RangeSet[Type]) Count() uint64 {
+	count := uint64(0)
+
+	for _, ivl := range set.intervals {
+		sum, err := AddU(count, ivl.Size())
+		if err != nil {
+			return intspec.MaxUint64
+		}
+
+		count = sum
+	}
+
+	return count
+}
+
+// Returns an iterator over disjoint intervals of the set in ascending order.
+func (set *RangeSet[Type]) Intervals() iter.Seq[Interval[Type]] {
+	return slices.Values(set.intervals)
+}
+
+// A range iterator for safely (without infinite loops due to counter overflow)
+// iterating over integers of the set in ascending order.
+func (set *RangeSet[Type]) All() iter.Seq[Type] {
+	iterator := func(yield func(Type) bool) {
+		for _, ivl := range set.intervals {
+			for number := range Inc(ivl.lower, ivl.upper) {
+				if !yield(number) {
+					return
+				}
+			}
+		}
+	}
+
+	return iterator
+}
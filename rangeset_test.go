@@ -0,0 +1,263 @@
+package safe
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/akramarenkov/intspec"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+func TestRangeSetAddRemove(t *testing.T) {
+	base := []Interval[int8]{
+		NewInterval[int8](math.MinInt8, -120),
+		NewInterval[int8](-50, -40),
+		NewInterval[int8](0, 0),
+		NewInterval[int8](2, 10),
+		NewInterval[int8](120, math.MaxInt8),
+	}
+
+	baseU := []Interval[uint8]{
+		NewInterval[uint8](0, 5),
+		NewInterval[uint8](7, 7),
+		NewInterval[uint8](100, 200),
+		NewInterval[uint8](math.MaxUint8, math.MaxUint8),
+	}
+
+	testRangeSetAddRemove(t, base)
+	testRangeSetAddRemove(t, baseU)
+}
+
+func testRangeSetAddRemove[Type int8 | uint8](t *testing.T, base []Interval[Type]) {
+	_, maximum := intspec.Range[Type]()
+
+	for lower := range Iter(intspec.Range[Type]()) {
+		for upper := range Iter(lower, maximum) {
+			ivl := NewInterval(lower, upper)
+
+			set := NewRangeSet(base...)
+			set.Add(ivl)
+
+			expected := modelRangeSet(base...)
+
+			for number := range ivl.Iter() {
+				expected[number] = true
+			}
+
+			requireRangeSet(t, expected, set, "added: %v", ivl)
+
+			set = NewRangeSet(base...)
+			set.Remove(ivl)
+
+			expected = modelRangeSet(base...)
+
+			for number := range ivl.Iter() {
+				delete(expected, number)
+			}
+
+			requireRangeSet(t, expected, set, "removed: %v", ivl)
+		}
+	}
+}
+
+func TestRangeSetOperations(t *testing.T) {
+	grid := []int8{math.MinInt8, math.MinInt8 + 1, -60, -59, -1, 0, 1, 2, 60, math.MaxInt8 - 1, math.MaxInt8}
+
+	sets := [][]Interval[int8]{
+		nil,
+		{
+			NewInterval[int8](math.MinInt8, -100),
+			NewInterval[int8](-60, -1),
+			NewInterval[int8](1, 1),
+			NewInterval[int8](60, math.MaxInt8),
+		},
+		{
+			NewInterval[int8](-99, -61),
+			NewInterval[int8](0, 0),
+			NewInterval[int8](2, 59),
+		},
+	}
+
+	for id, lower := range grid {
+		for _, upper := range grid[id:] {
+			sets = append(sets, []Interval[int8]{NewInterval(lower, upper)})
+		}
+	}
+
+	for _, first := range sets {
+		for _, second := range sets {
+			firstSet := NewRangeSet(first...)
+			secondSet := NewRangeSet(second...)
+
+			firstModel := modelRangeSet(first...)
+			secondModel := modelRangeSet(second...)
+
+			union := map[int8]bool{}
+			intersection := map[int8]bool{}
+			difference := map[int8]bool{}
+
+			for number := range Iter[int8](math.MinInt8, math.MaxInt8) {
+				if firstModel[number] || secondModel[number] {
+					union[number] = true
+				}
+
+				if firstModel[number] && secondModel[number] {
+					intersection[number] = true
+				}
+
+				if firstModel[number] && !secondModel[number] {
+					difference[number] = true
+				}
+			}
+
+			requireRangeSet(t, union, firstSet.Union(secondSet), "first: %v, second: %v", first, second)
+			requireRangeSet(t, intersection, firstSet.Intersect(secondSet), "first: %v, second: %v", first, second)
+			requireRangeSet(t, difference, firstSet.Difference(secondSet), "first: %v, second: %v", first, second)
+		}
+
+		complement := map[int8]bool{}
+		firstModel := modelRangeSet(first...)
+
+		for number := range Iter[int8](math.MinInt8, math.MaxInt8) {
+			if !firstModel[number] {
+				complement[number] = true
+			}
+		}
+
+		requireRangeSet(t, complement, NewRangeSet(first...).Complement(), "set: %v", first)
+	}
+}
+
+func modelRangeSet[Type constraints.Integer](intervals ...Interval[Type]) map[Type]bool {
+	model := make(map[Type]bool)
+
+	for _, ivl := range intervals {
+		for number := range ivl.Iter() {
+			model[number] = true
+		}
+	}
+
+	return model
+}
+
+func requireRangeSet[Type int8 | uint8](
+	t *testing.T,
+	expected map[Type]bool,
+	set *RangeSet[Type],
+	msgAndArgs ...any,
+) {
+	intervals := slices.Collect(set.Intervals())
+
+	for id := 1; id < len(intervals); id++ {
+		// Intervals are sorted and neither overlap nor adjoin each other
+		require.Greater(t, int(intervals[id].Min()), int(intervals[id-1].Max())+1, msgAndArgs...)
+	}
+
+	reference := []Type(nil)
+	contained := []Type(nil)
+
+	for number := range Iter(intspec.Range[Type]()) {
+		if expected[number] {
+			reference = append(reference, number)
+		}
+
+		if set.Contains(number) {
+			contained = append(contained, number)
+		}
+	}
+
+	require.Equal(t, reference, contained, msgAndArgs...)
+	require.Equal(t, reference, slices.Collect(set.All()), msgAndArgs...)
+	require.Equal(t, uint64(len(reference)), set.Count(), msgAndArgs...)
+}
+
+func TestRangeSetMax(t *testing.T) {
+	set := NewRangeSet(NewInterval[uint64](math.MaxUint64-1, math.MaxUint64))
+	set.Add(NewInterval[uint64](0, math.MaxUint64-2))
+
+	require.Equal(t, []Interval[uint64]{NewInterval[uint64](0, math.MaxUint64)}, slices.Collect(set.Intervals()))
+	require.Equal(t, uint64(math.MaxUint64), set.Count())
+	require.Empty(t, slices.Collect(set.Complement().Intervals()))
+
+	set.Remove(NewInterval[uint64](0, 0))
+	set.Remove(NewInterval[uint64](math.MaxUint64, math.MaxUint64))
+
+	require.Equal(t, []Interval[uint64]{NewInterval[uint64](1, math.MaxUint64-1)}, slices.Collect(set.Intervals()))
+	require.Equal(t, uint64(math.MaxUint64-1), set.Count())
+	require.Equal(
+		t,
+		[]Interval[uint64]{
+			NewInterval[uint64](0, 0),
+			NewInterval[uint64](math.MaxUint64, math.MaxUint64),
+		},
+		slices.Collect(set.Complement().Intervals()),
+	)
+
+	sset := NewRangeSet(NewInterval[int64](math.MinInt64, -1), NewInterval[int64](1, math.MaxInt64))
+	require.Equal(t, uint64(math.MaxUint64), sset.Count())
+	require.Equal(t, []Interval[int64]{NewInterval[int64](0, 0)}, slices.Collect(sset.Complement().Intervals()))
+
+	sset.Add(NewInterval[int64](0, 0))
+	require.Equal(t, uint64(math.MaxUint64), sset.Count())
+	require.Equal(
+		t,
+		[]Interval[int64]{NewInterval[int64](math.MinInt64, math.MaxInt64)},
+		slices.Collect(sset.Intervals()),
+	)
+}
+
+func TestRangeSetZeroValue(t *testing.T) {
+	var set RangeSet[int8]
+
+	require.False(t, set.Contains(0))
+	require.Zero(t, set.Count())
+	require.Empty(t, slices.Collect(set.All()))
+
+	set.Remove(NewInterval[int8](0, 0))
+	set.Add(NewInterval[int8](0, 0))
+	require.True(t, set.Contains(0))
+}
+
+func TestRangeSetPart(t *testing.T) {
+	set := NewRangeSet(NewInterval[int8](1, 3), NewInterval[int8](5, 6))
+
+	actual := []int8(nil)
+
+	for number := range set.All() {
+		if number > 5 {
+			break
+		}
+
+		actual = append(actual, number)
+	}
+
+	require.Equal(t, []int8{1, 2, 3, 5}, actual)
+}
+
+func BenchmarkRangeSetAdd(b *testing.B) {
+	set := NewRangeSet[int]()
+
+	for id := range b.N {
+		set.Add(NewInterval(3*id, 3*id+1))
+	}
+
+	require.NotZero(b, set.Count())
+}
+
+func BenchmarkRangeSetContains(b *testing.B) {
+	set := NewRangeSet[int]()
+
+	for id := range 1024 {
+		set.Add(NewInterval(3*id, 3*id+1))
+	}
+
+	contains := false
+
+	for id := range b.N {
+		contains = set.Contains(id%(3*1024)) || contains
+	}
+
+	require.True(b, contains)
+}
@@ -0,0 +1,476 @@
+package safe
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/akramarenkov/safe/internal/is"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Rational number represented as a fraction of two integers with the detection of
+// overflows in arithmetic operations.
+//
+// The fraction is always kept normalized: the denominator is positive, and the
+// numerator and the denominator have no common divisors other than one.
+//
+// The zero value is a valid rational number equal to zero.
+type Rational[Type constraints.Integer] struct {
+	num Type
+	den Type
+}
+
+// Creates a rational number from a numerator and a denominator and normalizes it.
+//
+// The denominator is checked for equality to zero.
+//
+// In case of overflow or denominator equal to zero, an error is returned. Overflow
+// is possible only if the normalized numerator or denominator does not fit into
+// the type, for example, for 1/-128 of int8 type.
+func NewRational[Type constraints.Integer](numerator, denominator Type) (Rational[Type], error) {
+	if denominator == 0 {
+		return Rational[Type]{}, ErrDivisionByZero
+	}
+
+	// numerator < 0 && denominator > 0 || numerator > 0 && denominator < 0
+	negative := numerator^denominator < 0
+
+	return newRational[Type](negative, Abs(numerator), Abs(denominator))
+}
+
+func newRational[Type constraints.Integer](
+	negative bool,
+	numerator uint64,
+	denominator uint64,
+) (Rational[Type], error) {
+	divisor := gcd(numerator, denominator)
+
+	num, err := fromAbs[Type](negative, numerator/divisor)
+	if err != nil {
+		return Rational[Type]{}, err
+	}
+
+	den, err := IToI[Type](denominator / divisor)
+	if err != nil {
+		return Rational[Type]{}, err
+	}
+
+	rat := Rational[Type]{
+		num: num,
+		den: den,
+	}
+
+	return rat, nil
+}
+
+// Returns the numerator of a normalized rational number.
+func (rat Rational[Type]) Num() Type {
+	return rat.num
+}
+
+// Returns the denominator of a normalized rational number. Always positive.
+func (rat Rational[Type]) Den() Type {
+	// Zero value of the rational number is equal to zero
+	if rat.den == 0 {
+		return 1
+	}
+
+	return rat.den
+}
+
+// Adds two rational numbers and detects whether an overflow has occurred or not.
+//
+// In case of overflow, an error is returned.
+func (rat Rational[Type]) Add(addend Rational[Type]) (Rational[Type], error) {
+	return rat.combine(addend, false)
+}
+
+// Subtracts two rational numbers (subtrahend from rat) and detects whether an overflow
+// has occurred or not.
+//
+// In case of overflow, an error is returned.
+func (rat Rational[Type]) Sub(subtrahend Rational[Type]) (Rational[Type], error) {
+	return rat.combine(subtrahend, true)
+}
+
+func (rat Rational[Type]) combine(other Rational[Type], subtract bool) (Rational[Type], error) {
+	divisor := gcd(Abs(rat.Den()), Abs(other.Den()))
+
+	// Cross-products are reduced by the greatest common divisor of the denominators
+	// before multiplication, that decreases the values of intermediate results.
+	// Calculations are performed with absolute values in 128 bits, so the
+	// intermediate results never overflow and overflow is detected only if the
+	// normalized result does not fit into the type
+	firstHigh, firstLow := bits.Mul64(Abs(rat.num), Abs(other.Den())/divisor)
+	secondHigh, secondLow := bits.Mul64(Abs(other.num), Abs(rat.Den())/divisor)
+
+	firstNegative := rat.num < 0
+	secondNegative := other.num < 0 != subtract
+
+	var high, low uint64
+
+	negative := firstNegative
+
+	switch {
+	case firstNegative == secondNegative:
+		var carry uint64
+
+		low, carry = bits.Add64(firstLow, secondLow, 0)
+		high, carry = bits.Add64(firstHigh, secondHigh, carry)
+
+		// Sum is not less than 2^128, and the divisor of the denominators, by which
+		// it is reduced, is less than 2^64, so the numerator does not fit into
+		// uint64
+		if carry != 0 {
+			return Rational[Type]{}, ErrOverflow
+		}
+	case firstHigh > secondHigh || firstHigh == secondHigh && firstLow >= secondLow:
+		var borrow uint64
+
+		low, borrow = bits.Sub64(firstLow, secondLow, 0)
+		high, _ = bits.Sub64(firstHigh, secondHigh, borrow)
+	default:
+		var borrow uint64
+
+		low, borrow = bits.Sub64(secondLow, firstLow, 0)
+		high, _ = bits.Sub64(secondHigh, firstHigh, borrow)
+		negative = secondNegative
+	}
+
+	// Obtained numerator can have common divisors only with the greatest common
+	// divisor of the denominators (see D. Knuth, The Art of Computer Programming,
+	// Vol. 2, 4.5.1), so the result is normalized by it
+	_, remainder := bits.Div64(high%divisor, low, divisor)
+	reducer := gcd(remainder, divisor)
+
+	// Quotient of the reduction does not fit into uint64
+	if high >= reducer {
+		return Rational[Type]{}, ErrOverflow
+	}
+
+	numerator, _ := bits.Div64(high, low, reducer)
+
+	denominator, err := MulU(Abs(rat.Den())/divisor, Abs(other.Den())/reducer)
+	if err != nil {
+		return Rational[Type]{}, err
+	}
+
+	return newRational[Type](negative, numerator, denominator)
+}
+
+// Multiplies two rational numbers and detects whether an overflow has occurred or not.
+//
+// In case of overflow, an error is returned.
+func (rat Rational[Type]) Mul(factor Rational[Type]) (Rational[Type], error) {
+	// Cross-reduction before multiplication makes the product normalized, so
+	// overflow is detected only when the result really does not fit into the type
+	first := gcd(Abs(rat.num), Abs(factor.Den()))
+	second := gcd(Abs(factor.num), Abs(rat.Den()))
+
+	numerator, err := MulU(Abs(rat.num)/first, Abs(factor.num)/second)
+	if err != nil {
+		return Rational[Type]{}, err
+	}
+
+	denominator, err := MulU(Abs(rat.Den())/second, Abs(factor.Den())/first)
+	if err != nil {
+		return Rational[Type]{}, err
+	}
+
+	// rat < 0 && factor > 0 || rat > 0 && factor < 0
+	negative := rat.num^factor.num < 0
+
+	return newRational[Type](negative, numerator, denominator)
+}
+
+// Divides two rational numbers (rat to divisor) and detects whether an overflow has
+// occurred or not.
+//
+// The divisor is also checked for equality to zero.
+//
+// In case of overflow or divisor equal to zero, an error is returned.
+func (rat Rational[Type]) Div(divisor Rational[Type]) (Rational[Type], error) {
+	if divisor.num == 0 {
+		return Rational[Type]{}, ErrDivisionByZero
+	}
+
+	first := gcd(Abs(rat.num), Abs(divisor.num))
+	second := gcd(Abs(rat.Den()), Abs(divisor.Den()))
+
+	numerator, err := MulU(Abs(rat.num)/first, Abs(divisor.Den())/second)
+	if err != nil {
+		return Rational[Type]{}, err
+	}
+
+	denominator, err := MulU(Abs(rat.Den())/second, Abs(divisor.num)/first)
+	if err != nil {
+		return Rational[Type]{}, err
+	}
+
+	// rat < 0 && divisor > 0 || rat > 0 && divisor < 0
+	negative := rat.num^divisor.num < 0
+
+	return newRational[Type](negative, numerator, denominator)
+}
+
+// Compares two rational numbers. Overflow is impossible.
+//
+// Returns -1 if rat is less than other, 0 if they are equal and +1 if rat is greater
+// than other.
+func (rat Rational[Type]) Cmp(other Rational[Type]) int {
+	ratSign := sign(rat.num)
+	otherSign := sign(other.num)
+
+	switch {
+	case ratSign < otherSign:
+		return -1
+	case ratSign > otherSign:
+		return 1
+	case ratSign == 0:
+		return 0
+	}
+
+	compared := compareFractions(
+		Abs(rat.num),
+		Abs(rat.Den()),
+		Abs(other.num),
+		Abs(other.Den()),
+	)
+
+	if ratSign < 0 {
+		return -compared
+	}
+
+	return compared
+}
+
+// Compares two non-negative fractions without multiplication by expanding them into
+// continued fractions.
+func compareFractions(firstNum, firstDen, secondNum, secondDen uint64) int {
+	for {
+		firstQuo := firstNum / firstDen
+		secondQuo := secondNum / secondDen
+
+		switch {
+		case firstQuo < secondQuo:
+			return -1
+		case firstQuo > secondQuo:
+			return 1
+		}
+
+		firstRem := firstNum % firstDen
+		secondRem := secondNum % secondDen
+
+		switch {
+		case firstRem == 0 && secondRem == 0:
+			return 0
+		case firstRem == 0:
+			return -1
+		case secondRem == 0:
+			return 1
+		}
+
+		// firstRem/firstDen < secondRem/secondDen is equivalent to
+		// secondDen/secondRem < firstDen/firstRem
+		firstNum, firstDen, secondNum, secondDen = secondDen, secondRem, firstDen, firstRem
+	}
+}
+
+func sign[Type constraints.Integer](number Type) int {
+	switch {
+	case number < 0:
+		return -1
+	case number > 0:
+		return 1
+	}
+
+	return 0
+}
+
+// Returns the greatest integer less than or equal to the rational number. Overflow
+// is impossible.
+func (rat Rational[Type]) Floor() Type {
+	quotient := rat.num / rat.Den()
+
+	// The quotient is not equal to the minimum value for the type if there is a
+	// remainder, so decrement does not lead to overflow
+	if rat.num < 0 && rat.num%rat.Den() != 0 {
+		return quotient - 1
+	}
+
+	return quotient
+}
+
+// Returns the least integer greater than or equal to the rational number. Overflow
+// is impossible.
+func (rat Rational[Type]) Ceil() Type {
+	quotient := rat.num / rat.Den()
+
+	// The quotient is not equal to the maximum value for the type if there is a
+	// remainder, so increment does not lead to overflow
+	if rat.num > 0 && rat.num%rat.Den() != 0 {
+		return quotient + 1
+	}
+
+	return quotient
+}
+
+// Returns a string representation of the rational number in the form of
+// numerator/denominator.
+func (rat Rational[Type]) String() string {
+	return fmt.Sprintf("%d/%d", rat.num, rat.Den())
+}
+
+// Converts a rational number to a floating point number and detects whether loss of
+// precision has occurred or not.
+//
+// Precision is considered lost if the numerator or the denominator cannot be
+// represented exactly by the floating point type. The quotient itself is rounded
+// as the result of an ordinary floating point division.
+//
+// In case of precision is lost, an error is returned.
+func RationalToFloat[Flt constraints.Float, Type constraints.Integer](
+	rat Rational[Type],
+) (Flt, error) {
+	numerator, err := IToF[Flt](rat.num)
+	if err != nil {
+		return 0, err
+	}
+
+	denominator, err := IToF[Flt](rat.Den())
+	if err != nil {
+		return 0, err
+	}
+
+	return numerator / denominator, nil
+}
+
+// Converts a floating point number to a rational number exactly and detects whether
+// an overflow or loss of precision has occurred or not.
+//
+// Number is also checked for equality to NaN.
+//
+// In case of number is equal to NaN, an error is returned. In case of integer part
+// or sign of the number does not fit into the type, an overflow error is returned.
+// In case of fractional part of the number cannot be represented exactly, a loss of
+// precision error is returned.
+func FloatToRational[Type constraints.Integer, Flt constraints.Float](
+	number Flt,
+) (Rational[Type], error) {
+	if _, err := FToI[Type](number); err != nil {
+		return Rational[Type]{}, err
+	}
+
+	// Negative numbers with an absolute value less than one pass the check of the
+	// integer part, but they are out of range of unsigned types
+	if number < 0 && !is.Signed[Type]() {
+		return Rational[Type]{}, ErrOverflow
+	}
+
+	// Conversion of float32 to float64 is always exact
+	fraction, exponent := math.Frexp(float64(number))
+
+	// Fraction is shifted left until it becomes an integer. Number of iterations
+	// does not exceed the mantissa size of float64
+	for fraction != math.Trunc(fraction) {
+		fraction *= 2
+		exponent--
+	}
+
+	// Absolute value of the fraction does not exceed 2^53 and fits into int64
+	mantissa := int64(fraction)
+
+	if exponent >= 0 {
+		numerator, err := IToI[Type](mantissa)
+		if err != nil {
+			return Rational[Type]{}, err
+		}
+
+		numerator, err = Shift(numerator, exponent)
+		if err != nil {
+			return Rational[Type]{}, err
+		}
+
+		return Rational[Type]{num: numerator, den: 1}, nil
+	}
+
+	// The mantissa is odd here, so the fraction is normalized
+	numerator, err := IToI[Type](mantissa)
+	if err != nil {
+		return Rational[Type]{}, ErrPrecisionLoss
+	}
+
+	denominator, err := Shift(Type(1), -exponent)
+	if err != nil {
+		return Rational[Type]{}, ErrPrecisionLoss
+	}
+
+	return Rational[Type]{num: numerator, den: denominator}, nil
+}
+
+// Converts a decimal number represented by a mantissa and a scale (mantissa / 10^scale)
+// to a rational number and detects whether loss of precision has occurred or not.
+//
+// In case of precision is lost, an error is returned. Precision is lost if the
+// normalized denominator does not fit into the type.
+func DecimalToRational[Type constraints.Integer](
+	mantissa Type,
+	scale uint,
+) (Rational[Type], error) {
+	ten := Rational[Type]{num: 10, den: 1}
+
+	rat := Rational[Type]{num: mantissa, den: 1}
+
+	// The denominator increases monotonically, so if the final denominator fits into
+	// the type, then the intermediate ones also fit. Number of iterations is limited
+	// by bit size of the type due to overflow or reduction of the numerator to zero
+	for range scale {
+		if rat.num == 0 {
+			break
+		}
+
+		quotient, err := rat.Div(ten)
+		if err != nil {
+			return Rational[Type]{}, ErrPrecisionLoss
+		}
+
+		rat = quotient
+	}
+
+	return rat, nil
+}
+
+// Converts a rational number to a decimal number represented by a mantissa with
+// specified scale (mantissa / 10^scale) and detects whether an overflow or loss of
+// precision has occurred or not.
+//
+// In case of overflow, an error is returned. In case of the rational number cannot
+// be represented exactly with specified scale, a loss of precision error is returned.
+func RationalToDecimal[Type constraints.Integer](rat Rational[Type], scale uint) (Type, error) {
+	ten := Rational[Type]{num: 10, den: 1}
+
+	// The numerator of intermediate results does not exceed the final mantissa
+	// in absolute value, so overflow is detected only if the final mantissa does
+	// not fit into the type. Number of iterations is limited by bit size of the type
+	// due to overflow
+	for range scale {
+		if rat.num == 0 {
+			return 0, nil
+		}
+
+		product, err := rat.Mul(ten)
+		if err != nil {
+			return 0, err
+		}
+
+		rat = product
+	}
+
+	if rat.Den() != 1 {
+		return 0, ErrPrecisionLoss
+	}
+
+	return rat.num, nil
+}
@@ -0,0 +1,405 @@
+package safe
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/akramarenkov/intspec"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+func TestNewRational(t *testing.T) {
+	rat, err := NewRational[int8](6, -4)
+	require.NoError(t, err)
+	require.Equal(t, int8(-3), rat.Num())
+	require.Equal(t, int8(2), rat.Den())
+
+	rat, err = NewRational[int8](-128, -128)
+	require.NoError(t, err)
+	require.Equal(t, int8(1), rat.Num())
+	require.Equal(t, int8(1), rat.Den())
+
+	rat, err = NewRational[int8](0, -128)
+	require.NoError(t, err)
+	require.Equal(t, int8(0), rat.Num())
+	require.Equal(t, int8(1), rat.Den())
+
+	rat, err = NewRational[int8](-128, 2)
+	require.NoError(t, err)
+	require.Equal(t, int8(-64), rat.Num())
+	require.Equal(t, int8(1), rat.Den())
+
+	_, err = NewRational[int8](-128, -1)
+	require.Error(t, err)
+
+	_, err = NewRational[int8](1, -128)
+	require.Error(t, err)
+
+	_, err = NewRational[int8](1, 0)
+	require.Error(t, err)
+
+	ratU, err := NewRational[uint8](200, 250)
+	require.NoError(t, err)
+	require.Equal(t, uint8(4), ratU.Num())
+	require.Equal(t, uint8(5), ratU.Den())
+}
+
+func TestRationalZeroValue(t *testing.T) {
+	zero := Rational[int8]{}
+
+	require.Equal(t, int8(0), zero.Num())
+	require.Equal(t, int8(1), zero.Den())
+	require.Equal(t, "0/1", zero.String())
+
+	one, err := NewRational[int8](1, 1)
+	require.NoError(t, err)
+
+	sum, err := zero.Add(one)
+	require.NoError(t, err)
+	require.Equal(t, one, sum)
+
+	product, err := zero.Mul(one)
+	require.NoError(t, err)
+	require.Equal(t, int8(0), product.Num())
+	require.Equal(t, int8(1), product.Den())
+
+	_, err = one.Div(zero)
+	require.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestRationalSig(t *testing.T) {
+	testRational(
+		t,
+		[]int8{-128, -127, -100, -64, -13, -7, -2, -1, 0, 1, 2, 3, 7, 13, 64, 100, 126, 127},
+		[]int8{1, 2, 3, 4, 7, 13, 64, 100, 127},
+	)
+}
+
+func TestRationalUns(t *testing.T) {
+	testRational(
+		t,
+		[]uint8{0, 1, 2, 3, 7, 13, 64, 100, 128, 200, 254, 255},
+		[]uint8{1, 2, 3, 4, 7, 13, 64, 100, 128, 255},
+	)
+}
+
+func TestRationalSig64(t *testing.T) {
+	testRational(
+		t,
+		[]int64{
+			math.MinInt64,
+			math.MinInt64 + 1,
+			-math.MaxInt64 / 3,
+			-1 << 32,
+			-1,
+			0,
+			1,
+			1 << 32,
+			math.MaxInt64 / 2,
+			math.MaxInt64 - 1,
+			math.MaxInt64,
+		},
+		[]int64{1, 2, 3, 6, 1 << 32, 1<<32 + 1, math.MaxInt64 - 1, math.MaxInt64},
+	)
+
+	first, err := NewRational[int64](math.MaxInt64, 2)
+	require.NoError(t, err)
+
+	second, err := NewRational[int64](-math.MaxInt64, 3)
+	require.NoError(t, err)
+
+	sum, err := first.Add(second)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MaxInt64), sum.Num())
+	require.Equal(t, int64(6), sum.Den())
+}
+
+func TestRationalUns64(t *testing.T) {
+	testRational(
+		t,
+		[]uint64{
+			0,
+			1,
+			2,
+			1 << 32,
+			math.MaxUint64 / 3,
+			math.MaxUint64 / 2,
+			math.MaxUint64 - 1,
+			math.MaxUint64,
+		},
+		[]uint64{1, 2, 3, 6, 1 << 32, 1<<32 + 1, math.MaxUint64 - 1, math.MaxUint64},
+	)
+
+	first, err := NewRational[uint64](math.MaxUint64, 2)
+	require.NoError(t, err)
+
+	second, err := NewRational[uint64](math.MaxUint64, 3)
+	require.NoError(t, err)
+
+	difference, err := first.Sub(second)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64/3), difference.Num())
+	require.Equal(t, uint64(2), difference.Den())
+}
+
+func testRational[Type constraints.Integer](t *testing.T, nums, dens []Type) {
+	rats := make([]Rational[Type], 0, len(nums)*len(dens))
+
+	for _, num := range nums {
+		for _, den := range dens {
+			rat, err := NewRational(num, den)
+			require.NoError(t, err)
+
+			rats = append(rats, rat)
+		}
+	}
+
+	for _, first := range rats {
+		for _, second := range rats {
+			testRationalOperation(t, first, second, first.Add, (*big.Rat).Add)
+			testRationalOperation(t, first, second, first.Sub, (*big.Rat).Sub)
+			testRationalOperation(t, first, second, first.Mul, (*big.Rat).Mul)
+
+			if second.Num() != 0 {
+				testRationalOperation(t, first, second, first.Div, (*big.Rat).Quo)
+			}
+
+			require.Equal(
+				t,
+				ratToBig(first).Cmp(ratToBig(second)),
+				first.Cmp(second),
+				"first: %v, second: %v",
+				first,
+				second,
+			)
+		}
+	}
+}
+
+func testRationalOperation[Type constraints.Integer](
+	t *testing.T,
+	first Rational[Type],
+	second Rational[Type],
+	inspected func(Rational[Type]) (Rational[Type], error),
+	reference func(*big.Rat, *big.Rat, *big.Rat) *big.Rat,
+) {
+	expected := reference(new(big.Rat), ratToBig(first), ratToBig(second))
+
+	actual, err := inspected(second)
+
+	if !bigFits[Type](expected.Num()) || !bigFits[Type](expected.Denom()) {
+		require.Error(t, err, "first: %v, second: %v", first, second)
+		return
+	}
+
+	require.NoError(t, err, "first: %v, second: %v", first, second)
+	require.Equal(
+		t,
+		expected.String(),
+		actual.String(),
+		"first: %v, second: %v",
+		first,
+		second,
+	)
+}
+
+func ratToBig[Type constraints.Integer](rat Rational[Type]) *big.Rat {
+	return new(big.Rat).SetFrac(intToBig(rat.Num()), intToBig(rat.Den()))
+}
+
+func intToBig[Type constraints.Integer](number Type) *big.Int {
+	if number < 0 {
+		return big.NewInt(int64(number))
+	}
+
+	return new(big.Int).SetUint64(uint64(number))
+}
+
+func bigFits[Type constraints.Integer](number *big.Int) bool {
+	minimum, maximum := intspec.Range[Type]()
+
+	if number.Cmp(intToBig(minimum)) < 0 {
+		return false
+	}
+
+	return number.Cmp(intToBig(maximum)) <= 0
+}
+
+func TestRationalFloorCeil(t *testing.T) {
+	for num := range Iter[int8](math.MinInt8, math.MaxInt8) {
+		for den := range Iter[int8](1, math.MaxInt8) {
+			rat, err := NewRational(num, den)
+			require.NoError(t, err)
+
+			reference := float64(num) / float64(den)
+
+			require.Equal(t, math.Floor(reference), float64(rat.Floor()), "rat: %v", rat)
+			require.Equal(t, math.Ceil(reference), float64(rat.Ceil()), "rat: %v", rat)
+		}
+	}
+}
+
+func TestRationalToFloat(t *testing.T) {
+	rat, err := NewRational[int64](30000, 1001)
+	require.NoError(t, err)
+
+	converted, err := RationalToFloat[float64](rat)
+	require.NoError(t, err)
+	require.InDelta(t, 29.97002997002997, converted, 1e-12)
+
+	rat, err = NewRational[int64](math.MaxInt64, 3)
+	require.NoError(t, err)
+
+	_, err = RationalToFloat[float64](rat)
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+
+	rat, err = NewRational[int64](1, math.MaxInt64)
+	require.NoError(t, err)
+
+	_, err = RationalToFloat[float64](rat)
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+}
+
+func TestFloatToRational(t *testing.T) {
+	testFloatToRational[int8](t, 0, 0, 1)
+	testFloatToRational[int8](t, 0.5, 1, 2)
+	testFloatToRational[int8](t, -0.75, -3, 4)
+	testFloatToRational[int8](t, -128, -128, 1)
+	testFloatToRational[int8](t, 127, 127, 1)
+	testFloatToRational[int8](t, 63.5, 127, 2)
+	testFloatToRational[int8](t, 1.0/64, 1, 64)
+	testFloatToRational[int64](t, 0.1, 3602879701896397, 36028797018963968)
+	testFloatToRational[int64](t, 1<<62, 1<<62, 1)
+
+	_, err := FloatToRational[int8](128.0)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	_, err = FloatToRational[int8](-129.0)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	_, err = FloatToRational[int8](1.0 / 128)
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = FloatToRational[int8](64.5)
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = FloatToRational[int8](0.1)
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = FloatToRational[uint8](-0.5)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	_, err = FloatToRational[uint8](-1.5)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	testFloatToRational[uint8](t, 0.5, 1, 2)
+
+	_, err = FloatToRational[int8](math.NaN())
+	require.ErrorIs(t, err, ErrNaN)
+
+	_, err = FloatToRational[int8](math.Inf(1))
+	require.ErrorIs(t, err, ErrOverflow)
+}
+
+func testFloatToRational[Type constraints.Integer](
+	t *testing.T,
+	number float64,
+	num Type,
+	den Type,
+) {
+	rat, err := FloatToRational[Type](number)
+	require.NoError(t, err, "number: %v", number)
+	require.Equal(t, num, rat.Num(), "number: %v", number)
+	require.Equal(t, den, rat.Den(), "number: %v", number)
+
+	converted, err := RationalToFloat[float64](rat)
+	require.NoError(t, err, "number: %v", number)
+	require.Equal(t, number, converted, "number: %v", number)
+}
+
+func TestDecimalToRational(t *testing.T) {
+	rat, err := DecimalToRational[int8](125, 3)
+	require.NoError(t, err)
+	require.Equal(t, "1/8", rat.String())
+
+	rat, err = DecimalToRational[int8](-50, 2)
+	require.NoError(t, err)
+	require.Equal(t, "-1/2", rat.String())
+
+	rat, err = DecimalToRational[int8](0, math.MaxUint)
+	require.NoError(t, err)
+	require.Equal(t, "0/1", rat.String())
+
+	rat, err = DecimalToRational[int8](100, 0)
+	require.NoError(t, err)
+	require.Equal(t, "100/1", rat.String())
+
+	_, err = DecimalToRational[int8](1, 3)
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = DecimalToRational[int8](1, math.MaxUint)
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+}
+
+func TestRationalToDecimal(t *testing.T) {
+	rat, err := NewRational[int8](1, 8)
+	require.NoError(t, err)
+
+	mantissa, err := RationalToDecimal(rat, 3)
+	require.NoError(t, err)
+	require.Equal(t, int8(125), mantissa)
+
+	_, err = RationalToDecimal(rat, 2)
+	require.ErrorIs(t, err, ErrPrecisionLoss)
+
+	_, err = RationalToDecimal(rat, 4)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	rat, err = NewRational[int8](-64, 5)
+	require.NoError(t, err)
+
+	mantissa, err = RationalToDecimal(rat, 1)
+	require.NoError(t, err)
+	require.Equal(t, int8(-128), mantissa)
+
+	mantissa, err = RationalToDecimal(Rational[int8]{}, math.MaxUint)
+	require.NoError(t, err)
+	require.Equal(t, int8(0), mantissa)
+
+	_, err = RationalToDecimal(rat, math.MaxUint)
+	require.ErrorIs(t, err, ErrOverflow)
+}
+
+func BenchmarkRationalAdd(b *testing.B) {
+	first, err := NewRational[int64](30000, 1001)
+	require.NoError(b, err)
+
+	second, err := NewRational[int64](24000, 1001)
+	require.NoError(b, err)
+
+	sum := Rational[int64]{}
+
+	for range b.N {
+		sum, _ = first.Add(second)
+	}
+
+	require.NotNil(b, sum)
+}
+
+func BenchmarkRationalMul(b *testing.B) {
+	first, err := NewRational[int64](30000, 1001)
+	require.NoError(b, err)
+
+	second, err := NewRational[int64](1001, 24000)
+	require.NoError(b, err)
+
+	product := Rational[int64]{}
+
+	for range b.N {
+		product, _ = first.Mul(second)
+	}
+
+	require.NotNil(b, product)
+}
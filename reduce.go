@@ -0,0 +1,189 @@
+package safe
+
+import (
+	"iter"
+
+	"github.com/akramarenkov/safe/internal/wide"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Adds up integers of a sequence and detects whether an overflow has occurred or not.
+//
+// As in [AddM], an error is returned only if the final sum does not fit into the type,
+// even if partial sums overflow in the order of the sequence. Sequence is not
+// materialized, integers are summed up in a 128-bit accumulator.
+//
+// In case of overflow or empty sequence, an error is returned.
+func SumSeq[Type constraints.Integer](seq iter.Seq[Type]) (Type, error) {
+	sum, count, err := sumSeq(seq)
+	if err != nil {
+		return 0, err
+	}
+
+	if count == 0 {
+		return 0, ErrMissingArguments
+	}
+
+	return fromWide[Type](sum)
+}
+
+// Multiplies integers of a sequence and detects whether an overflow has occurred or
+// not.
+//
+// As in [MulM], if the sequence contains zero, then zero is returned even if partial
+// products overflow before it. So, in case of overflow the sequence is read to the end.
+//
+// In case of overflow or empty sequence, an error is returned.
+func ProductSeq[Type constraints.Integer](seq iter.Seq[Type]) (Type, error) {
+	var (
+		negative bool
+		found    bool
+		failure  error
+	)
+
+	// Product is calculated as an absolute value and a sign, because the absolute
+	// value of the product of non-zero integers does not decrease, so if the final
+	// product fits into the type, then the absolute values of all partial products
+	// fit into uint64. After an overflow only multiplication by zero gives a correct
+	// result
+	abs := uint64(1)
+
+	for factor := range seq {
+		if factor == 0 {
+			return 0, nil
+		}
+
+		found = true
+
+		if factor < 0 {
+			negative = !negative
+		}
+
+		if failure != nil {
+			continue
+		}
+
+		interim, err := MulU(abs, Abs(factor))
+		if err != nil {
+			failure = err
+			continue
+		}
+
+		abs = interim
+	}
+
+	if !found {
+		return 0, ErrMissingArguments
+	}
+
+	if failure != nil {
+		return 0, failure
+	}
+
+	return fromAbs[Type](negative, abs)
+}
+
+// Returns the minimum integer of a sequence.
+//
+// In case of empty sequence, an error is returned.
+func MinSeq[Type constraints.Integer](seq iter.Seq[Type]) (Type, error) {
+	var (
+		minimum Type
+		found   bool
+	)
+
+	for number := range seq {
+		if !found || number < minimum {
+			minimum, found = number, true
+		}
+	}
+
+	if !found {
+		return 0, ErrMissingArguments
+	}
+
+	return minimum, nil
+}
+
+// Returns the maximum integer of a sequence.
+//
+// In case of empty sequence, an error is returned.
+func MaxSeq[Type constraints.Integer](seq iter.Seq[Type]) (Type, error) {
+	var (
+		maximum Type
+		found   bool
+	)
+
+	for number := range seq {
+		if !found || number > maximum {
+			maximum, found = number, true
+		}
+	}
+
+	if !found {
+		return 0, ErrMissingArguments
+	}
+
+	return maximum, nil
+}
+
+// Calculates the arithmetic mean of integers of a sequence. The mean is rounded down,
+// i.e. towards negative infinity, as in the [Mean] function.
+//
+// Mean always fits into the type, integers are summed up in a 128-bit accumulator, so
+// an overflow does not occur for sequences shorter than 2^63 integers.
+//
+// In case of overflow or empty sequence, an error is returned.
+func MeanSeq[Type constraints.Integer](seq iter.Seq[Type]) (Type, error) {
+	sum, count, err := sumSeq(seq)
+	if err != nil {
+		return 0, err
+	}
+
+	if count == 0 {
+		return 0, ErrMissingArguments
+	}
+
+	return quoWide[Type](sum, count, false)
+}
+
+// Adds up integers of a sequence in a 128-bit accumulator and counts them.
+func sumSeq[Type constraints.Integer](seq iter.Seq[Type]) (wide.Int, uint64, error) {
+	sum := wide.Int{}
+	count := uint64(0)
+
+	for number := range seq {
+		interim, ok := sum.Add(toWide(number))
+		if !ok {
+			return wide.Int{}, 0, ErrOverflow
+		}
+
+		counted, err := AddU(count, 1)
+		if err != nil {
+			return wide.Int{}, 0, err
+		}
+
+		sum, count = interim, counted
+	}
+
+	return sum, count, nil
+}
+
+// Converts an integer to a 128-bit integer.
+func toWide[Type constraints.Integer](number Type) wide.Int {
+	return wide.New(number < 0, Abs(number))
+}
+
+// Converts a 128-bit integer to an integer of specified type and detects whether an
+// overflow has occurred or not.
+//
+// In case of overflow, an error is returned.
+func fromWide[Type constraints.Integer](number wide.Int) (Type, error) {
+	negative, high, low := number.Abs()
+	if high != 0 {
+		return 0, ErrOverflow
+	}
+
+	return fromAbs[Type](negative, low)
+}
@@ -0,0 +1,223 @@
+package safe
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/akramarenkov/intspec"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+func TestReduceSeq(t *testing.T) {
+	testReduceSeq(t, []int8{math.MinInt8, math.MinInt8 + 1, -100, -2, -1, 0, 1, 2, 3, 100, math.MaxInt8 - 1, math.MaxInt8})
+	testReduceSeq(t, []uint8{0, 1, 2, 3, 100, 200, math.MaxUint8 - 1, math.MaxUint8})
+}
+
+func testReduceSeq[Type constraints.Integer](t *testing.T, dataset []Type) {
+	for first := range 4 {
+		for _, numbers := range combinations(dataset, first) {
+			testReduceSeqNumbers(t, numbers)
+		}
+	}
+}
+
+// Returns all sequences of the specified length consisting of the dataset items.
+func combinations[Type constraints.Integer](dataset []Type, length int) [][]Type {
+	if length == 0 {
+		return [][]Type{nil}
+	}
+
+	sequences := [][]Type(nil)
+
+	for _, shorter := range combinations(dataset, length-1) {
+		for _, number := range dataset {
+			sequences = append(sequences, append(slices.Clone(shorter), number))
+		}
+	}
+
+	return sequences
+}
+
+func testReduceSeqNumbers[Type constraints.Integer](t *testing.T, numbers []Type) {
+	seq := slices.Values(numbers)
+
+	sum, err := SumSeq(seq)
+	expected, fits := referenceSum(numbers)
+	require.Equal(t, fits, err == nil, "numbers: %v, error: %v", numbers, err)
+	require.Equal(t, expected, sum, "numbers: %v", numbers)
+
+	product, err := ProductSeq(seq)
+	expected, fits = referenceProduct(numbers)
+	require.Equal(t, fits, err == nil, "numbers: %v, error: %v", numbers, err)
+	require.Equal(t, expected, product, "numbers: %v", numbers)
+
+	mean, err := MeanSeq(seq)
+	expected, fits = referenceMean(numbers)
+	require.Equal(t, fits, err == nil, "numbers: %v, error: %v", numbers, err)
+	require.Equal(t, expected, mean, "numbers: %v", numbers)
+
+	minimum, err := MinSeq(seq)
+	maximum, errMax := MaxSeq(seq)
+
+	if len(numbers) == 0 {
+		require.ErrorIs(t, err, ErrMissingArguments)
+		require.ErrorIs(t, errMax, ErrMissingArguments)
+
+		return
+	}
+
+	require.NoError(t, err)
+	require.NoError(t, errMax)
+	require.Equal(t, slices.Min(numbers), minimum)
+	require.Equal(t, slices.Max(numbers), maximum)
+}
+
+func referenceFits[Type constraints.Integer](reference int64) (Type, bool) {
+	minimum, maximum := intspec.Range[Type]()
+
+	if reference < int64(minimum) || reference > int64(maximum) {
+		return 0, false
+	}
+
+	return Type(reference), true
+}
+
+func referenceSum[Type constraints.Integer](numbers []Type) (Type, bool) {
+	if len(numbers) == 0 {
+		return 0, false
+	}
+
+	reference := int64(0)
+
+	for _, number := range numbers {
+		reference += int64(number)
+	}
+
+	return referenceFits[Type](reference)
+}
+
+func referenceProduct[Type constraints.Integer](numbers []Type) (Type, bool) {
+	if len(numbers) == 0 {
+		return 0, false
+	}
+
+	reference := int64(1)
+
+	for _, number := range numbers {
+		reference *= int64(number)
+	}
+
+	return referenceFits[Type](reference)
+}
+
+func referenceMean[Type constraints.Integer](numbers []Type) (Type, bool) {
+	if len(numbers) == 0 {
+		return 0, false
+	}
+
+	reference := int64(0)
+
+	for _, number := range numbers {
+		reference += int64(number)
+	}
+
+	mean := reference / int64(len(numbers))
+
+	// Mean is rounded towards negative infinity
+	if reference%int64(len(numbers)) < 0 {
+		mean--
+	}
+
+	return referenceFits[Type](mean)
+}
+
+func TestSumSeqPartialOverflow(t *testing.T) {
+	sum, err := SumSeq(slices.Values([]int64{math.MaxInt64, math.MaxInt64, math.MinInt64, math.MinInt64, 1}))
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), sum)
+
+	sum, err = SumSeq(slices.Values([]int64{math.MinInt64, math.MinInt64, math.MaxInt64, 2}))
+	require.NoError(t, err)
+	require.Equal(t, int64(-math.MaxInt64), sum)
+
+	_, err = SumSeq(slices.Values([]int64{math.MaxInt64, math.MaxInt64, math.MinInt64, 2}))
+	require.ErrorIs(t, err, ErrOverflow)
+
+	sumU, err := SumSeq(slices.Values([]uint64{math.MaxUint64 - 1, 1}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), sumU)
+
+	_, err = SumSeq(slices.Values([]uint64{math.MaxUint64, 1}))
+	require.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestProductSeqPartialOverflow(t *testing.T) {
+	product, err := ProductSeq(slices.Values([]int64{math.MaxInt64, math.MaxInt64, 0}))
+	require.NoError(t, err)
+	require.Zero(t, product)
+
+	_, err = ProductSeq(slices.Values([]int64{math.MaxInt64, math.MaxInt64, 1}))
+	require.ErrorIs(t, err, ErrOverflow)
+
+	product, err = ProductSeq(slices.Values([]int64{math.MinInt64, -1, -1}))
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MinInt64), product)
+}
+
+func TestMeanSeqMax(t *testing.T) {
+	mean, err := MeanSeq(slices.Values([]int64{math.MaxInt64, math.MaxInt64, math.MaxInt64}))
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MaxInt64), mean)
+
+	mean, err = MeanSeq(slices.Values([]int64{math.MinInt64, math.MinInt64, math.MaxInt64}))
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MinInt64/3-1), mean)
+
+	meanU, err := MeanSeq(slices.Values([]uint64{math.MaxUint64, math.MaxUint64 - 2}))
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64-1), meanU)
+}
+
+func TestReduceSeqBreak(t *testing.T) {
+	seq := Inc[int8](math.MinInt8, math.MaxInt8)
+
+	sum, err := SumSeq(seq)
+	require.NoError(t, err)
+	require.Equal(t, int8(math.MinInt8), sum)
+
+	mean, err := MeanSeq(seq)
+	require.NoError(t, err)
+	require.Equal(t, int8(-1), mean)
+}
+
+func BenchmarkSumSeq(b *testing.B) {
+	sum := int64(0)
+
+	for range b.N {
+		sum, _ = SumSeq(Inc[int64](1, 1000))
+	}
+
+	require.NotZero(b, sum)
+}
+
+func BenchmarkProductSeq(b *testing.B) {
+	product := int64(0)
+
+	for range b.N {
+		product, _ = ProductSeq(Inc[int64](1, 20))
+	}
+
+	require.NotZero(b, product)
+}
+
+func BenchmarkMeanSeq(b *testing.B) {
+	mean := int64(0)
+
+	for range b.N {
+		mean, _ = MeanSeq(Inc[int64](1, 1000))
+	}
+
+	require.NotZero(b, mean)
+}
@@ -0,0 +1,176 @@
+package safe
+
+import (
+	"math/bits"
+
+	"github.com/akramarenkov/safe/internal/is"
+
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
+
+type roundingMode int
+
+const (
+	roundingDown roundingMode = iota + 1
+	roundingUp
+	roundingNearest
+)
+
+// Rounds an integer down, i.e. towards negative infinity, to a multiple of the
+// specified integer and detects whether an overflow has occurred or not.
+//
+// Multiples of an integer and of its negation are the same, so the sign of the
+// multiple does not affect the result.
+//
+// In case of overflow or multiple equal to zero, an error is returned.
+func RoundDown[Type constraints.Integer](number, multiple Type) (Type, error) {
+	return roundToMultiple(number, multiple, roundingDown)
+}
+
+// Rounds an integer up, i.e. towards positive infinity, to a multiple of the
+// specified integer and detects whether an overflow has occurred or not.
+//
+// Multiples of an integer and of its negation are the same, so the sign of the
+// multiple does not affect the result.
+//
+// In case of overflow or multiple equal to zero, an error is returned.
+func RoundUp[Type constraints.Integer](number, multiple Type) (Type, error) {
+	return roundToMultiple(number, multiple, roundingUp)
+}
+
+// Rounds an integer to the nearest multiple of the specified integer, halves are
+// rounded away from zero, and detects whether an overflow has occurred or not.
+//
+// Multiples of an integer and of its negation are the same, so the sign of the
+// multiple does not affect the result.
+//
+// In case of overflow or multiple equal to zero, an error is returned.
+func RoundNearest[Type constraints.Integer](number, multiple Type) (Type, error) {
+	return roundToMultiple(number, multiple, roundingNearest)
+}
+
+func roundToMultiple[Type constraints.Integer](number, multiple Type, mode roundingMode) (Type, error) {
+	if multiple == 0 {
+		return 0, ErrDivisionByZero
+	}
+
+	negative := number < 0
+
+	// Calculation is performed with absolute values, so the minimum value for signed
+	// types and its multiples are processed without overflow
+	abs := Abs(number)
+	step := Abs(multiple)
+
+	remainder := abs % step
+
+	if remainder == 0 {
+		return number, nil
+	}
+
+	truncated := abs - remainder
+
+	away := false
+
+	switch mode {
+	case roundingDown:
+		away = negative
+	case roundingUp:
+		away = !negative
+	case roundingNearest:
+		away = remainder >= step-remainder
+	}
+
+	if !away {
+		return fromAbs[Type](negative, truncated)
+	}
+
+	rounded, err := AddU(truncated, step)
+	if err != nil {
+		return 0, err
+	}
+
+	return fromAbs[Type](negative, rounded)
+}
+
+// Detects whether an integer is a power of two. Zero and negative integers are not
+// powers of two.
+func IsPow2[Type constraints.Integer](number Type) bool {
+	return number > 0 && number&(number-1) == 0
+}
+
+// Rounds an integer down, i.e. towards negative infinity, to a multiple of the
+// alignment that is a power of two.
+//
+// Faster than the [RoundDown] function.
+//
+// In case of alignment that is not a power of two, an error is returned.
+func AlignDownPow2[Type constraints.Integer](number, alignment Type) (Type, error) {
+	if !IsPow2(alignment) {
+		return 0, ErrAlignmentInvalid
+	}
+
+	// Minimum value for signed types is a multiple of any power of two representable
+	// by the type, so rounding down does not overflow
+	return number &^ (alignment - 1), nil
+}
+
+// Rounds an integer up, i.e. towards positive infinity, to a multiple of the
+// alignment that is a power of two and detects whether an overflow has occurred or
+// not.
+//
+// Faster than the [RoundUp] function.
+//
+// In case of overflow or alignment that is not a power of two, an error is returned.
+func AlignUpPow2[Type constraints.Integer](number, alignment Type) (Type, error) {
+	if !IsPow2(alignment) {
+		return 0, ErrAlignmentInvalid
+	}
+
+	aligned := number &^ (alignment - 1)
+
+	if aligned == number {
+		return number, nil
+	}
+
+	return Add(aligned, alignment)
+}
+
+// Calculates the smallest power of two that is not less than an integer and detects
+// whether an overflow has occurred or not.
+//
+// For integers less than or equal to one, one is returned.
+//
+// In case of overflow, an error is returned.
+func NextPow2[Type constraints.Integer](number Type) (Type, error) {
+	if number <= 1 {
+		return 1, nil
+	}
+
+	// Maximum power of two for signed types is less than for unsigned types of the
+	// same bit size
+	limit := intspec.BitSize[Type]()
+
+	if is.Signed[Type]() {
+		limit--
+	}
+
+	exponent := bits.Len64(uint64(number - 1))
+
+	if exponent >= limit {
+		return 0, ErrOverflow
+	}
+
+	return 1 << exponent, nil
+}
+
+// Calculates the largest power of two that is not greater than an integer.
+//
+// In case of zero or negative integer, an error is returned.
+func PrevPow2[Type constraints.Integer](number Type) (Type, error) {
+	if number <= 0 {
+		return 0, ErrNumberNotPositive
+	}
+
+	return 1 << (bits.Len64(uint64(number)) - 1), nil
+}
@@ -0,0 +1,227 @@
+package safe
+
+import (
+	"math"
+	"math/bits"
+	"testing"
+
+	"github.com/akramarenkov/intspec"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+func TestRound(t *testing.T) {
+	testRound[int8](t)
+	testRound[uint8](t)
+}
+
+func testRound[Type constraints.Integer](t *testing.T) {
+	minimum, maximum := intspec.Range[Type]()
+
+	for number := range Iter(minimum, maximum) {
+		for multiple := range Iter(minimum, maximum) {
+			if multiple == 0 {
+				continue
+			}
+
+			down, up, nearest := referenceRound(int64(number), int64(multiple))
+
+			testRoundResult(t, down, number, multiple, RoundDown)
+			testRoundResult(t, up, number, multiple, RoundUp)
+			testRoundResult(t, nearest, number, multiple, RoundNearest)
+		}
+	}
+}
+
+func testRoundResult[Type constraints.Integer](
+	t *testing.T,
+	reference int64,
+	number Type,
+	multiple Type,
+	round func(Type, Type) (Type, error),
+) {
+	actual, err := round(number, multiple)
+
+	expected, fits := referenceFits[Type](reference)
+	if !fits {
+		require.ErrorIs(t, err, ErrOverflow, "number: %v, multiple: %v", number, multiple)
+		return
+	}
+
+	require.NoError(t, err, "number: %v, multiple: %v", number, multiple)
+	require.Equal(t, expected, actual, "number: %v, multiple: %v", number, multiple)
+}
+
+func referenceRound(number, multiple int64) (int64, int64, int64) {
+	step := max(multiple, -multiple)
+
+	down := number / step * step
+
+	if down > number {
+		down -= step
+	}
+
+	up := down
+
+	if up < number {
+		up += step
+	}
+
+	nearest := down
+
+	switch {
+	case number-down > up-number:
+		nearest = up
+	case number-down == up-number && number > 0:
+		nearest = up
+	}
+
+	return down, up, nearest
+}
+
+func TestRoundSpecial(t *testing.T) {
+	_, err := RoundUp(1, 0)
+	require.ErrorIs(t, err, ErrDivisionByZero)
+
+	_, err = RoundDown(1, 0)
+	require.ErrorIs(t, err, ErrDivisionByZero)
+
+	_, err = RoundNearest(1, 0)
+	require.ErrorIs(t, err, ErrDivisionByZero)
+
+	rounded, err := RoundUp[uint64](math.MaxUint64-4095, 4096)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64-4095), rounded)
+
+	_, err = RoundUp[uint64](math.MaxUint64-4094, 4096)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	_, err = RoundUp[uint64](math.MaxUint64, math.MaxUint64-1)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	rounded, err = RoundDown[uint64](math.MaxUint64, 4096)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64-4095), rounded)
+
+	_, err = RoundDown[int64](math.MinInt64+1, 3)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	signed, err := RoundDown[int64](math.MinInt64+1, math.MinInt64)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MinInt64), signed)
+
+	signed, err = RoundNearest[int64](-6, -4)
+	require.NoError(t, err)
+	require.Equal(t, int64(-8), signed)
+
+	signed, err = RoundNearest[int64](6, -4)
+	require.NoError(t, err)
+	require.Equal(t, int64(8), signed)
+}
+
+func TestPow2(t *testing.T) {
+	testPow2[int8](t)
+	testPow2[uint8](t)
+}
+
+func testPow2[Type constraints.Integer](t *testing.T) {
+	minimum, maximum := intspec.Range[Type]()
+
+	for number := range Iter(minimum, maximum) {
+		reference := int64(number)
+
+		require.Equal(t, reference > 0 && bits.OnesCount64(uint64(reference)) == 1, IsPow2(number))
+
+		next := int64(1)
+
+		for next < reference {
+			next *= 2
+		}
+
+		expected, fits := referenceFits[Type](next)
+
+		actual, err := NextPow2(number)
+		if fits {
+			require.NoError(t, err, "number: %v", number)
+			require.Equal(t, expected, actual, "number: %v", number)
+		} else {
+			require.ErrorIs(t, err, ErrOverflow, "number: %v", number)
+		}
+
+		actual, err = PrevPow2(number)
+		if reference <= 0 {
+			require.ErrorIs(t, err, ErrNumberNotPositive)
+		} else {
+			require.NoError(t, err)
+			require.LessOrEqual(t, actual, number)
+			require.True(t, IsPow2(actual))
+			require.Greater(t, int64(actual)*2, reference)
+		}
+
+		for alignment := range Iter(minimum, maximum) {
+			down, errDown := AlignDownPow2(number, alignment)
+
+			if !IsPow2(alignment) {
+				_, errUp := AlignUpPow2(number, alignment)
+
+				require.ErrorIs(t, errDown, ErrAlignmentInvalid)
+				require.ErrorIs(t, errUp, ErrAlignmentInvalid)
+
+				continue
+			}
+
+			referenceDown, referenceUp, _ := referenceRound(reference, int64(alignment))
+
+			expectedDown, fits := referenceFits[Type](referenceDown)
+			require.True(t, fits)
+			require.NoError(t, errDown)
+			require.Equal(t, expectedDown, down, "number: %v, alignment: %v", number, alignment)
+
+			testRoundResult(t, referenceUp, number, alignment, AlignUpPow2)
+		}
+	}
+}
+
+func TestPow2Special(t *testing.T) {
+	next, err := NextPow2[uint64](1<<63 + 1)
+	require.ErrorIs(t, err, ErrOverflow)
+	require.Zero(t, next)
+
+	next, err = NextPow2[uint64](1 << 63)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<63), next)
+
+	_, err = NextPow2[int64](1<<62 + 1)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	previous, err := PrevPow2[uint64](math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<63), previous)
+
+	_, err = AlignUpPow2[int64](math.MaxInt64, 1<<62)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	aligned, err := AlignDownPow2[int64](math.MinInt64+1, 1<<62)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MinInt64), aligned)
+}
+
+func BenchmarkRoundUp(b *testing.B) {
+	rounded := uint64(0)
+
+	for id := range b.N {
+		rounded, _ = RoundUp(uint64(id)+1, 4096)
+	}
+
+	require.NotZero(b, rounded)
+}
+
+func BenchmarkAlignUpPow2(b *testing.B) {
+	aligned := uint64(0)
+
+	for id := range b.N {
+		aligned, _ = AlignUpPow2(uint64(id)+1, 4096)
+	}
+
+	require.NotZero(b, aligned)
+}
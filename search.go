@@ -0,0 +1,43 @@
+package safe
+
+import "golang.org/x/exp/constraints"
+
+// Uses binary search to find the smallest value in the range from lo to hi inclusive
+// at which the predicate is true, assuming that the predicate is monotone, i.e. if it
+// is true at some value, then it is true at all larger values of the range.
+//
+// Unlike [sort.Search], it works with any integer type and the full range of its
+// values without overflow when calculating the midpoint of the range.
+//
+// The predicate is called no more than the bit size of the type plus one times.
+//
+// If the predicate is false at all values of the range or lo is greater than hi, then
+// false is returned.
+func Search[Type constraints.Integer](lo, hi Type, pred func(Type) bool) (Type, bool) {
+	if lo > hi {
+		return 0, false
+	}
+
+	satisfied := false
+
+	for lo < hi {
+		// Midpoint is rounded down and is less than hi, so there is no overflow
+		// when it is increased by one
+		mid := Midpoint(lo, hi)
+
+		if pred(mid) {
+			hi = mid
+			satisfied = true
+
+			continue
+		}
+
+		lo = mid + 1
+	}
+
+	if satisfied || pred(hi) {
+		return hi, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,110 @@
+package safe
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/akramarenkov/intspec"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+func TestSearch(t *testing.T) {
+	bounds := []int8{math.MinInt8, math.MinInt8 + 1, -3, 0, 5, math.MaxInt8 - 1, math.MaxInt8}
+	boundsU := []uint8{0, 1, 5, math.MaxUint8 - 1, math.MaxUint8}
+
+	for _, lo := range bounds {
+		for _, hi := range bounds {
+			for threshold := range Iter[int](math.MinInt8, math.MaxInt8+1) {
+				testSearch(t, lo, hi, threshold)
+			}
+		}
+	}
+
+	for _, lo := range boundsU {
+		for _, hi := range boundsU {
+			for threshold := range Iter[int](0, math.MaxUint8+1) {
+				testSearch(t, lo, hi, threshold)
+			}
+		}
+	}
+}
+
+func testSearch[Type constraints.Integer](t *testing.T, lo, hi Type, threshold int) {
+	calls := 0
+
+	pred := func(number Type) bool {
+		require.GreaterOrEqual(t, number, lo)
+		require.LessOrEqual(t, number, hi)
+
+		calls++
+
+		return int(number) >= threshold
+	}
+
+	actual, found := Search(lo, hi, pred)
+	require.LessOrEqual(t, calls, intspec.BitSize[Type]()+1)
+
+	if lo > hi {
+		require.False(t, found)
+		require.Zero(t, calls)
+
+		return
+	}
+
+	// Reference uses indices in the range from lo to hi
+	size := int(hi) - int(lo) + 1
+
+	reference := sort.Search(size, func(id int) bool {
+		return int(lo)+id >= threshold
+	})
+
+	if reference == size {
+		require.False(t, found, "lo: %v, hi: %v, threshold: %v", lo, hi, threshold)
+		require.Zero(t, actual)
+
+		return
+	}
+
+	require.True(t, found, "lo: %v, hi: %v, threshold: %v", lo, hi, threshold)
+	require.Equal(t, int(lo)+reference, int(actual), "lo: %v, hi: %v, threshold: %v", lo, hi, threshold)
+}
+
+func TestSearchMax(t *testing.T) {
+	for _, threshold := range []int64{math.MinInt64, -1, 0, math.MaxInt64 - 1, math.MaxInt64} {
+		calls := 0
+
+		actual, found := Search[int64](math.MinInt64, math.MaxInt64, func(number int64) bool {
+			calls++
+			return number >= threshold
+		})
+
+		require.True(t, found)
+		require.Equal(t, threshold, actual)
+		require.LessOrEqual(t, calls, intspec.BitSize64+1)
+	}
+
+	actual, found := Search[uint64](0, math.MaxUint64, func(number uint64) bool {
+		return number >= math.MaxUint64
+	})
+	require.True(t, found)
+	require.Equal(t, uint64(math.MaxUint64), actual)
+
+	_, found = Search[uint64](0, math.MaxUint64, func(uint64) bool {
+		return false
+	})
+	require.False(t, found)
+}
+
+func BenchmarkSearch(b *testing.B) {
+	number := uint64(0)
+
+	for id := range b.N {
+		number, _ = Search[uint64](0, math.MaxUint64, func(number uint64) bool {
+			return number > uint64(id)
+		})
+	}
+
+	require.NotZero(b, number)
+}
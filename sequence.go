@@ -0,0 +1,251 @@
+package safe
+
+import (
+	"iter"
+	"math/bits"
+
+	"github.com/akramarenkov/safe/internal/is"
+
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
+
+// An iterator over the arithmetic progression start, start+delta, start+2*delta, ...
+// that stops at the last value representable by the type.
+//
+// In addition to the main integer, its index in the progression is returned.
+//
+// If delta is zero, then only start is returned.
+func Arithmetic[Type constraints.Integer](start, delta Type) iter.Seq2[uint64, Type] {
+	iterator := func(yield func(uint64, Type) bool) {
+		value := start
+
+		for id := uint64(0); ; id++ {
+			if !yield(id, value) {
+				return
+			}
+
+			if delta == 0 {
+				return
+			}
+
+			next, err := Add(value, delta)
+			if err != nil {
+				return
+			}
+
+			value = next
+		}
+	}
+
+	return iterator
+}
+
+// An iterator over the geometric progression start, start*ratio, start*ratio^2, ...
+// that stops at the last value representable by the type. Used, for example, for
+// doubling backoff delays and geometric bucket boundaries of histograms.
+//
+// In addition to the main integer, its index in the progression is returned.
+//
+// Iteration also stops when the progression starts repeating values, i.e. if start
+// is zero or ratio is -1, 0 or 1, then only distinct values are returned.
+func Geometric[Type constraints.Integer](start, ratio Type) iter.Seq2[uint64, Type] {
+	iterator := func(yield func(uint64, Type) bool) {
+		value := start
+
+		// Previous value is initialized with the current one, so it does not
+		// affect the detection of repeating values at the first step
+		previous := start
+
+		for id := uint64(0); ; id++ {
+			if !yield(id, value) {
+				return
+			}
+
+			next, err := Mul(value, ratio)
+			if err != nil {
+				return
+			}
+
+			// Progression with an absolute value of the ratio not less than two
+			// and a non-zero start does not repeat values, otherwise it is periodic
+			// with a period not greater than two
+			if next == value || next == previous {
+				return
+			}
+
+			previous, value = value, next
+		}
+	}
+
+	return iterator
+}
+
+// An iterator over the powers of base: 1, base, base^2, ... that stops at the last
+// value representable by the type. Used, for example, to obtain powers of 10 for
+// formatting.
+//
+// In addition to the main integer, its exponent is returned.
+//
+// As in [Geometric], if base is -1, 0 or 1, then only distinct values are returned.
+func Powers[Type constraints.Integer](base Type) iter.Seq2[uint64, Type] {
+	return Geometric(1, base)
+}
+
+// An iterator over the Fibonacci numbers 0, 1, 1, 2, 3, 5, ... that stops at the last
+// number representable by the type.
+//
+// In addition to the main integer, its index in the sequence is returned.
+func Fibonacci[Type constraints.Integer]() iter.Seq2[uint64, Type] {
+	iterator := func(yield func(uint64, Type) bool) {
+		current, next := Type(0), Type(1)
+
+		for id := uint64(0); ; id++ {
+			if !yield(id, current) {
+				return
+			}
+
+			following, err := Add(current, next)
+			if err != nil {
+				// Next number is representable, it just has not been returned yet
+				yield(id+1, next)
+				return
+			}
+
+			current, next = next, following
+		}
+	}
+
+	return iterator
+}
+
+// Calculates the sum of the first count terms of the arithmetic progression start,
+// start+delta, start+2*delta, ... using a closed-form expression and detects whether
+// an overflow has occurred or not.
+//
+// In case of overflow of the sum or of one of the terms of the progression, an error
+// is returned.
+func ArithmeticSum[Type constraints.Integer](start, delta Type, count uint64) (Type, error) {
+	if count == 0 {
+		return 0, nil
+	}
+
+	// Distance between the first and last terms is equal to (count-1)*|delta|
+	high, distance := bits.Mul64(count-1, Abs(delta))
+	if high != 0 {
+		return 0, ErrOverflow
+	}
+
+	// Last term must be representable
+	minimum, maximum := intspec.Range[Type]()
+
+	if delta >= 0 && distance > Dist(start, maximum) || delta < 0 && distance > Dist(start, minimum) {
+		return 0, ErrOverflow
+	}
+
+	// Sum is equal to count*start + delta*count*(count-1)/2, where the second
+	// term is equal to sign(delta)*distance*count/2. Terms are represented as
+	// 128-bit absolute values and signs. The product count*(count-1) is even, so
+	// the division by two is exact
+	startHigh, startLow := bits.Mul64(count, Abs(start))
+
+	deltaHigh, deltaLow := bits.Mul64(distance, count)
+	deltaLow = deltaLow>>1 | deltaHigh<<(intspec.BitSize64-1)
+	deltaHigh >>= 1
+
+	var low uint64
+
+	negative := start < 0
+
+	switch {
+	case (start < 0) == (delta < 0):
+		var carry uint64
+
+		low, carry = bits.Add64(startLow, deltaLow, 0)
+		high, carry = bits.Add64(startHigh, deltaHigh, carry)
+
+		if carry != 0 {
+			return 0, ErrOverflow
+		}
+	case startHigh > deltaHigh || startHigh == deltaHigh && startLow >= deltaLow:
+		var borrow uint64
+
+		low, borrow = bits.Sub64(startLow, deltaLow, 0)
+		high, _ = bits.Sub64(startHigh, deltaHigh, borrow)
+	default:
+		var borrow uint64
+
+		negative = delta < 0
+
+		low, borrow = bits.Sub64(deltaLow, startLow, 0)
+		high, _ = bits.Sub64(deltaHigh, startHigh, borrow)
+	}
+
+	if high != 0 {
+		return 0, ErrOverflow
+	}
+
+	return fromAbs[Type](negative, low)
+}
+
+// Calculates the sum of the first count terms of the geometric progression start,
+// start*ratio, start*ratio^2, ... and detects whether an overflow has occurred or not.
+//
+// The number of calculation steps does not exceed the bit size of the type, because
+// terms of the progression with an absolute value of the ratio not less than two
+// quickly overflow, and sums for other ratios are calculated directly.
+//
+// In case of overflow of the sum or of one of the terms of the progression, an error
+// is returned.
+func GeometricSum[Type constraints.Integer](start, ratio Type, count uint64) (Type, error) {
+	if count == 0 || start == 0 {
+		return 0, nil
+	}
+
+	switch {
+	case ratio == 0:
+		return start, nil
+	case ratio == 1:
+		// Count may not fit into the type while the sum does, so the sum is
+		// calculated with the absolute value of the start
+		abs, err := MulU(Abs(start), count)
+		if err != nil {
+			return 0, err
+		}
+
+		return fromAbs[Type](start < 0, abs)
+	case is.MinusOne(ratio):
+		if count == 1 {
+			return start, nil
+		}
+
+		// Terms equal to -start must be representable
+		if _, err := Negate(start); err != nil {
+			return 0, err
+		}
+
+		if count%2 == 0 {
+			return 0, nil
+		}
+
+		return start, nil
+	}
+
+	sum := start
+	term := start
+
+	for range count - 1 {
+		next, err := Mul(term, ratio)
+		if err != nil {
+			return 0, err
+		}
+
+		term = next
+
+		if sum, err = Add(sum, term); err != nil {
+			return 0, err
+		}
+	}
+
+	return sum, nil
+}
@@ -0,0 +1,401 @@
+package safe
+
+import (
+	"math"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/akramarenkov/intspec"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+func TestArithmetic(t *testing.T) {
+	for start := range Iter[int8](math.MinInt8, math.MaxInt8) {
+		for delta := range Iter[int8](math.MinInt8, math.MaxInt8) {
+			testArithmetic(t, start, delta)
+		}
+	}
+
+	for start := range Iter[uint8](0, math.MaxUint8) {
+		for delta := range Iter[uint8](0, math.MaxUint8) {
+			testArithmetic(t, start, delta)
+		}
+	}
+}
+
+func testArithmetic[Type int8 | uint8](t *testing.T, start, delta Type) {
+	minimum, maximum := intspec.Range[Type]()
+
+	expected := []Type{start}
+
+	for value := int(start) + int(delta); delta != 0; value += int(delta) {
+		if value < int(minimum) || value > int(maximum) {
+			break
+		}
+
+		expected = append(expected, Type(value))
+	}
+
+	actual := []Type(nil)
+
+	for id, value := range Arithmetic(start, delta) {
+		require.Equal(t, uint64(len(actual)), id)
+
+		actual = append(actual, value)
+	}
+
+	require.Equal(t, expected, actual, "start: %v, delta: %v", start, delta)
+}
+
+func TestGeometric(t *testing.T) {
+	for start := range Iter[int8](math.MinInt8, math.MaxInt8) {
+		for ratio := range Iter[int8](math.MinInt8, math.MaxInt8) {
+			testGeometric(t, start, ratio)
+		}
+	}
+
+	for start := range Iter[uint8](0, math.MaxUint8) {
+		for ratio := range Iter[uint8](0, math.MaxUint8) {
+			testGeometric(t, start, ratio)
+		}
+	}
+}
+
+func testGeometric[Type int8 | uint8](t *testing.T, start, ratio Type) {
+	minimum, maximum := intspec.Range[Type]()
+
+	expected := []Type{start}
+
+	for value := int(start) * int(ratio); ; value *= int(ratio) {
+		if value < int(minimum) || value > int(maximum) || slices.Contains(expected, Type(value)) {
+			break
+		}
+
+		expected = append(expected, Type(value))
+	}
+
+	actual := []Type(nil)
+
+	for id, value := range Geometric(start, ratio) {
+		require.Equal(t, uint64(len(actual)), id)
+
+		actual = append(actual, value)
+	}
+
+	require.Equal(t, expected, actual, "start: %v, ratio: %v", start, ratio)
+}
+
+func TestPowers(t *testing.T) {
+	require.Equal(t, []int8{1, 2, 4, 8, 16, 32, 64}, collectSeq2(Powers[int8](2)))
+	require.Equal(t, []int8{1, -2, 4, -8, 16, -32, 64, -128}, collectSeq2(Powers[int8](-2)))
+	require.Equal(t, []uint8{1, 10, 100}, collectSeq2(Powers[uint8](10)))
+	require.Equal(t, []int8{1, 0}, collectSeq2(Powers[int8](0)))
+	require.Equal(t, []int8{1}, collectSeq2(Powers[int8](1)))
+	require.Equal(t, []int8{1, -1}, collectSeq2(Powers[int8](-1)))
+
+	powers := collectSeq2(Powers[uint64](10))
+	require.Len(t, powers, len(pow10Table))
+
+	for exponent, power := range Powers[uint64](10) {
+		require.Equal(t, pow10Table[exponent], power)
+	}
+}
+
+func TestFibonacci(t *testing.T) {
+	require.Equal(
+		t,
+		[]uint8{0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89, 144, 233},
+		collectSeq2(Fibonacci[uint8]()),
+	)
+
+	require.Equal(
+		t,
+		[]int8{0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89},
+		collectSeq2(Fibonacci[int8]()),
+	)
+
+	numbers := collectSeq2(Fibonacci[uint64]())
+	require.Len(t, numbers, 94)
+	require.Equal(t, uint64(12200160415121876738), numbers[len(numbers)-1])
+
+	snumbers := collectSeq2(Fibonacci[int64]())
+	require.Len(t, snumbers, 93)
+	require.Equal(t, int64(7540113804746346429), snumbers[len(snumbers)-1])
+
+	for id := range uint64(len(numbers) - 2) {
+		require.Equal(t, numbers[id]+numbers[id+1], numbers[id+2])
+	}
+}
+
+func TestSequencePart(t *testing.T) {
+	for id := range Arithmetic[int8](0, 1) {
+		if id == 1 {
+			break
+		}
+	}
+
+	for id := range Geometric[int8](1, 2) {
+		if id == 1 {
+			break
+		}
+	}
+
+	for id := range Fibonacci[int8]() {
+		if id == 1 {
+			break
+		}
+	}
+
+	for id := range Fibonacci[int8]() {
+		if id == 11 {
+			break
+		}
+	}
+}
+
+func collectSeq2[Type constraints.Integer](seq func(func(uint64, Type) bool)) []Type {
+	collected := []Type(nil)
+
+	for _, value := range seq {
+		collected = append(collected, value)
+	}
+
+	return collected
+}
+
+func TestArithmeticSum(t *testing.T) {
+	counts := []uint64{0, 1, 2, 3, 5, 100, 255, 256, 257, math.MaxUint64}
+
+	for _, count := range counts {
+		for start := range Iter[int8](math.MinInt8, math.MaxInt8) {
+			for delta := range Iter[int8](math.MinInt8, math.MaxInt8) {
+				testArithmeticSum(t, start, delta, count)
+			}
+		}
+
+		for start := range Iter[uint8](0, math.MaxUint8) {
+			for delta := range Iter[uint8](0, math.MaxUint8) {
+				testArithmeticSum(t, start, delta, count)
+			}
+		}
+	}
+}
+
+func testArithmeticSum[Type int8 | uint8](t *testing.T, start, delta Type, count uint64) {
+	sum, err := ArithmeticSum(start, delta, count)
+
+	reference, fits := referenceArithmeticSum(start, delta, count)
+	if !fits {
+		require.ErrorIs(t, err, ErrOverflow, "start: %v, delta: %v, count: %v", start, delta, count)
+		return
+	}
+
+	require.NoError(t, err, "start: %v, delta: %v, count: %v", start, delta, count)
+	require.Equal(t, reference, sum, "start: %v, delta: %v, count: %v", start, delta, count)
+}
+
+func referenceArithmeticSum[Type int8 | uint8](start, delta Type, count uint64) (Type, bool) {
+	if count == 0 {
+		return 0, true
+	}
+
+	minimum, maximum := intspec.Range[Type]()
+
+	bigCount := new(big.Int).SetUint64(count)
+	bigStart := big.NewInt(int64(start))
+	bigDelta := big.NewInt(int64(delta))
+
+	last := new(big.Int).Sub(bigCount, big.NewInt(1))
+	last.Mul(last, bigDelta)
+	last.Add(last, bigStart)
+
+	sum := new(big.Int).Sub(bigCount, big.NewInt(1))
+	sum.Mul(sum, bigCount)
+	sum.Mul(sum, bigDelta)
+	sum.Rsh(sum, 1)
+	sum.Add(sum, new(big.Int).Mul(bigCount, bigStart))
+
+	for _, value := range []*big.Int{last, sum} {
+		if value.Cmp(big.NewInt(int64(minimum))) < 0 || value.Cmp(big.NewInt(int64(maximum))) > 0 {
+			return 0, false
+		}
+	}
+
+	return Type(sum.Int64()), true
+}
+
+func TestArithmeticSumMax(t *testing.T) {
+	sum, err := ArithmeticSum[int64](math.MinInt64+1, 1, math.MaxUint64)
+	require.NoError(t, err)
+	require.Zero(t, sum)
+
+	sum, err = ArithmeticSum[int64](math.MaxInt64, -1, math.MaxUint64)
+	require.NoError(t, err)
+	require.Zero(t, sum)
+
+	sum, err = ArithmeticSum[int64](math.MinInt64, math.MaxInt64, 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(-3), sum)
+
+	_, err = ArithmeticSum[int64](math.MinInt64, 1, math.MaxUint64)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	usum, err := ArithmeticSum[uint64](0, 1, 1<<32)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<63-1<<31), usum)
+
+	_, err = ArithmeticSum[uint64](1, 1, 1<<33)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	usum, err = ArithmeticSum[uint64](math.MaxUint64, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), usum)
+
+	_, err = ArithmeticSum[uint64](1, math.MaxUint64, 2)
+	require.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestGeometricSum(t *testing.T) {
+	counts := []uint64{0, 1, 2, 3, 4, 5, 7, 8, 9, 100, 127, 128, 129, 255, 256, 257, math.MaxUint64 - 1, math.MaxUint64}
+
+	for _, count := range counts {
+		for start := range Iter[int8](math.MinInt8, math.MaxInt8) {
+			for ratio := range Iter[int8](math.MinInt8, math.MaxInt8) {
+				testGeometricSum(t, start, ratio, count)
+			}
+		}
+
+		for start := range Iter[uint8](0, math.MaxUint8) {
+			for ratio := range Iter[uint8](0, math.MaxUint8) {
+				testGeometricSum(t, start, ratio, count)
+			}
+		}
+	}
+}
+
+func testGeometricSum[Type int8 | uint8](t *testing.T, start, ratio Type, count uint64) {
+	sum, err := GeometricSum(start, ratio, count)
+
+	reference, fits := referenceGeometricSum(start, ratio, count)
+	if !fits {
+		require.ErrorIs(t, err, ErrOverflow, "start: %v, ratio: %v, count: %v", start, ratio, count)
+		return
+	}
+
+	require.NoError(t, err, "start: %v, ratio: %v, count: %v", start, ratio, count)
+	require.Equal(t, reference, sum, "start: %v, ratio: %v, count: %v", start, ratio, count)
+}
+
+func referenceGeometricSum[Type int8 | uint8](start, ratio Type, count uint64) (Type, bool) {
+	minimum, maximum := intspec.Range[Type]()
+
+	sum := 0
+	term := int(start)
+
+	// Terms of progression with an absolute value of the ratio not less than two
+	// exceed 64-bit integers after 64 steps, and other progressions are periodic
+	// with period not greater than two
+	for id := range min(count, 66) {
+		if term < int(minimum) || term > int(maximum) {
+			return 0, false
+		}
+
+		sum += term
+
+		if id != count-1 {
+			term *= int(ratio)
+		}
+	}
+
+	if count > 66 {
+		if ratio == 1 {
+			if start == 0 {
+				return 0, true
+			}
+
+			// Absolute value of the sum is not less than the count
+			if count > math.MaxUint8+1 {
+				return 0, false
+			}
+
+			sum = int(start) * int(count)
+		}
+
+		// Sum of 66 terms of the periodic progressions with period of two is
+		// equal to the sum of any even number of terms
+		if ratio+1 == 0 && count%2 == 1 {
+			sum += term
+		}
+	}
+
+	if sum < int(minimum) || sum > int(maximum) {
+		return 0, false
+	}
+
+	return Type(sum), true
+}
+
+func TestGeometricSumMax(t *testing.T) {
+	sum8, err := GeometricSum[int8](-1, 1, 128)
+	require.NoError(t, err)
+	require.Equal(t, int8(-128), sum8)
+
+	sum, err := GeometricSum[uint64](1, 2, 64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), sum)
+
+	_, err = GeometricSum[uint64](1, 2, 65)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	ssum, err := GeometricSum[int64](-1, -2, 63)
+	require.NoError(t, err)
+	require.Equal(t, int64(-3074457345618258603), ssum)
+
+	_, err = GeometricSum[int64](-1, -2, 64)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	_, err = GeometricSum[int64](math.MinInt64, -1, 2)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	ssum, err = GeometricSum[int64](math.MinInt64, -1, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MinInt64), ssum)
+
+	ssum, err = GeometricSum[int64](math.MaxInt64, -1, math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MaxInt64), ssum)
+}
+
+func BenchmarkArithmeticSum(b *testing.B) {
+	sum := int64(0)
+
+	for range b.N {
+		sum, _ = ArithmeticSum[int64](-100, 3, 1000)
+	}
+
+	require.NotZero(b, sum)
+}
+
+func BenchmarkGeometricSum(b *testing.B) {
+	sum := int64(0)
+
+	for range b.N {
+		sum, _ = GeometricSum[int64](1, 2, 62)
+	}
+
+	require.NotZero(b, sum)
+}
+
+func BenchmarkPowers(b *testing.B) {
+	power := uint64(0)
+
+	for range b.N {
+		for _, value := range Powers[uint64](10) {
+			power = value
+		}
+	}
+
+	require.NotZero(b, power)
+}
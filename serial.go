@@ -0,0 +1,91 @@
+package safe
+
+import (
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
+
+// Serial number arithmetic as defined in RFC 1982. Used in protocols such as DNS (SOA
+// serials), RTP (sequence numbers) and TCP (sequence numbers), where serial numbers
+// wrap around and are compared modulo 2^bits, where bits is the bit size of the type.
+
+// Returns half of the serial number range, i.e. 2^(bits-1).
+func serialHalf[Type constraints.Unsigned]() Type {
+	return Type(1) << (intspec.BitSize[Type]() - 1)
+}
+
+// Adds a positive integer to a serial number as defined in RFC 1982.
+//
+// The addend must not exceed 2^(bits-1)-1, where bits is the bit size of the type.
+//
+// In case of the addend exceeds the allowed value, an error is returned.
+func SerialAdd[Type constraints.Unsigned](serial, addend Type) (Type, error) {
+	if addend >= serialHalf[Type]() {
+		return 0, ErrSerialAddend
+	}
+
+	// Wrapping around is intended by definition
+	return serial + addend, nil
+}
+
+// Compares two serial numbers as defined in RFC 1982.
+//
+// Returns -1 if first is less than second, 0 if they are equal and +1 if first is
+// greater than second.
+//
+// If the serial numbers are at a distance of exactly half the range from each other,
+// then their comparison is undefined.
+//
+// In case of the comparison is undefined, an error is returned.
+func SerialCompare[Type constraints.Unsigned](first, second Type) (int, error) {
+	// Wrapping around is intended by definition
+	diff := second - first
+
+	switch half := serialHalf[Type](); {
+	case diff == 0:
+		return 0, nil
+	case diff == half:
+		return 0, ErrSerialUndefined
+	case diff < half:
+		return -1, nil
+	}
+
+	return 1, nil
+}
+
+// Detects whether first serial number is less than second as defined in RFC 1982.
+//
+// In case of the comparison is undefined, an error is returned. See [SerialCompare].
+func SerialLess[Type constraints.Unsigned](first, second Type) (bool, error) {
+	compared, err := SerialCompare(first, second)
+	if err != nil {
+		return false, err
+	}
+
+	return compared < 0, nil
+}
+
+// Calculates the signed distance from one serial number to another, i.e. such a
+// number that adding it to the first serial number (modulo 2^bits) gives the second
+// serial number and its absolute value is less than 2^(bits-1).
+//
+// The distance is positive if to is greater than from in terms of RFC 1982, and
+// negative otherwise.
+//
+// In case of the comparison of serial numbers is undefined, an error is returned.
+// See [SerialCompare].
+func SerialDistance[Type constraints.Unsigned](from, to Type) (int64, error) {
+	// Wrapping around is intended by definition
+	diff := to - from
+
+	switch half := serialHalf[Type](); {
+	case diff == half:
+		return 0, ErrSerialUndefined
+	case diff < half:
+		// Is less than 2^63 and fits into int64
+		return int64(diff), nil
+	}
+
+	// Negated difference is less than half of the range and fits into int64
+	return -int64(-diff), nil
+}
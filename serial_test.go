@@ -0,0 +1,125 @@
+package safe
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSerialAdd(t *testing.T) {
+	for serial := range Iter[uint8](0, math.MaxUint8) {
+		for addend := range Iter[uint8](0, math.MaxUint8) {
+			sum, err := SerialAdd(serial, addend)
+
+			if addend > math.MaxInt8 {
+				require.ErrorIs(t, err, ErrSerialAddend)
+				require.Zero(t, sum)
+
+				continue
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, uint8((int(serial)+int(addend))%(math.MaxUint8+1)), sum)
+
+			compared, err := SerialCompare(serial, sum)
+			require.NoError(t, err)
+
+			if addend == 0 {
+				require.Equal(t, 0, compared)
+				continue
+			}
+
+			require.Equal(t, -1, compared, "serial: %v, addend: %v", serial, addend)
+		}
+	}
+
+	sum, err := SerialAdd[uint64](math.MaxUint64, math.MaxInt64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxInt64-1), sum)
+
+	_, err = SerialAdd[uint64](0, math.MaxInt64+1)
+	require.ErrorIs(t, err, ErrSerialAddend)
+}
+
+func TestSerialCompare(t *testing.T) {
+	for first := range Iter[uint8](0, math.MaxUint8) {
+		for second := range Iter[uint8](0, math.MaxUint8) {
+			compared, errCompare := SerialCompare(first, second)
+			less, errLess := SerialLess(first, second)
+			distance, errDistance := SerialDistance(first, second)
+
+			reference := referenceSerialCompare(int(first), int(second))
+
+			if reference == nil {
+				require.ErrorIs(t, errCompare, ErrSerialUndefined)
+				require.ErrorIs(t, errLess, ErrSerialUndefined)
+				require.ErrorIs(t, errDistance, ErrSerialUndefined)
+
+				continue
+			}
+
+			require.NoError(t, errCompare)
+			require.NoError(t, errLess)
+			require.NoError(t, errDistance)
+
+			require.Equal(t, *reference, compared, "first: %v, second: %v", first, second)
+			require.Equal(t, *reference < 0, less, "first: %v, second: %v", first, second)
+
+			require.Less(t, distance, int64(math.MaxInt8+1))
+			require.Greater(t, distance, int64(math.MinInt8))
+			require.Equal(t, second, first+uint8(distance), "first: %v, second: %v", first, second)
+			require.Equal(t, -*reference, sign(distance), "first: %v, second: %v", first, second)
+		}
+	}
+}
+
+// Implements the definition from RFC 1982 for 8-bit serial numbers.
+func referenceSerialCompare(first, second int) *int {
+	const half = 128
+
+	compared := 0
+
+	switch {
+	case first == second:
+	case first < second && second-first < half, first > second && first-second > half:
+		compared = -1
+	case first < second && second-first > half, first > second && first-second < half:
+		compared = 1
+	default:
+		return nil
+	}
+
+	return &compared
+}
+
+func TestSerialDistance(t *testing.T) {
+	distance, err := SerialDistance[uint32](math.MaxUint32-1, 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), distance)
+
+	distance, err = SerialDistance[uint32](3, math.MaxUint32-1)
+	require.NoError(t, err)
+	require.Equal(t, int64(-5), distance)
+
+	distance, err = SerialDistance[uint64](0, math.MaxInt64)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MaxInt64), distance)
+
+	distance, err = SerialDistance[uint64](math.MaxInt64, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(-math.MaxInt64), distance)
+
+	_, err = SerialDistance[uint64](0, math.MaxInt64+1)
+	require.ErrorIs(t, err, ErrSerialUndefined)
+}
+
+func BenchmarkSerialCompare(b *testing.B) {
+	compared := 0
+
+	for range b.N {
+		compared, _ = SerialCompare[uint16](math.MaxUint16, 1)
+	}
+
+	require.NotZero(b, compared)
+}
@@ -0,0 +1,106 @@
+package safe
+
+import (
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
+
+// Defines the behavior of the [ShiftRight] function when the shift count is not less
+// than the bit width of the type.
+type ShiftMode int
+
+const (
+	// Shift count not less than the bit width of the type is an error.
+	ShiftModeStrict ShiftMode = iota
+	// Shift count not less than the bit width of the type is allowed, as in the Go
+	// language, and all bits of the integer are replaced with its sign bit, i.e. the
+	// result is -1 for negative integers and 0 for others.
+	ShiftModeFill
+)
+
+// Returns the bit width of the integer type.
+func BitWidth[Type constraints.Integer]() int {
+	return intspec.BitSize[Type]()
+}
+
+// Shifts an integer left to specified shift count like the [Shift] function, but
+// also prohibits a shift count not less than the bit width of the type, even if the
+// result is zero.
+//
+// In case of overflow, shift count is negative or not less than the bit width of the
+// type, an error is returned.
+func ShiftLeftStrict[Type, CountType constraints.Integer](number Type, count CountType) (Type, error) {
+	if count < 0 {
+		return 0, ErrNegativeShift
+	}
+
+	if count >= CountType(BitWidth[Type]()) {
+		return 0, ErrLargeShift
+	}
+
+	return Shift(number, count)
+}
+
+// Shifts an integer right to specified shift count. For signed types the shift is
+// arithmetic, i.e. the sign bit is propagated and the result is rounded towards
+// negative infinity, for unsigned types the shift is logical.
+//
+// Right shift does not overflow, but the shift count is checked for negativity and,
+// depending on the mode, for being not less than the bit width of the type.
+//
+// In case of shift count is negative or, in the strict mode, not less than the bit
+// width of the type, an error is returned.
+func ShiftRight[Type, CountType constraints.Integer](number Type, count CountType, mode ShiftMode) (Type, error) {
+	if count < 0 {
+		return 0, ErrNegativeShift
+	}
+
+	if mode != ShiftModeFill && count >= CountType(BitWidth[Type]()) {
+		return 0, ErrLargeShift
+	}
+
+	return number >> count, nil
+}
+
+// Rotates bits of an integer left to specified rotation count modulo the bit width of
+// the type. Negative rotation count rotates bits right, as in the [math/bits.RotateLeft]
+// function.
+//
+// Signed integers are rotated as their two's complement representation.
+func RotateLeft[Type, CountType constraints.Integer](number Type, count CountType) Type {
+	width := BitWidth[Type]()
+
+	// Bit width of any integer type is representable by any integer type
+	shift := count % CountType(width)
+
+	if shift < 0 {
+		shift += CountType(width)
+	}
+
+	if shift == 0 {
+		return number
+	}
+
+	// Bits of the integer are rotated in the lower part of the uint64, the upper part
+	// is discarded when converting back to the type
+	mask := uint64(1)<<(width-1)<<1 - 1
+	value := uint64(number) & mask
+
+	return Type(value<<shift | value>>(CountType(width)-shift))
+}
+
+// Rotates bits of an integer right to specified rotation count modulo the bit width
+// of the type. Negative rotation count rotates bits left.
+//
+// Signed integers are rotated as their two's complement representation.
+func RotateRight[Type, CountType constraints.Integer](number Type, count CountType) Type {
+	width := BitWidth[Type]()
+
+	shift := count % CountType(width)
+
+	if shift < 0 {
+		shift += CountType(width)
+	}
+
+	return RotateLeft(number, width-int(shift))
+}
@@ -0,0 +1,169 @@
+package safe
+
+import (
+	"math"
+	"math/bits"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type customUint16 uint16
+
+func TestBitWidth(t *testing.T) {
+	require.Equal(t, 8, BitWidth[int8]())
+	require.Equal(t, 8, BitWidth[uint8]())
+	require.Equal(t, 16, BitWidth[customUint16]())
+	require.Equal(t, 32, BitWidth[int32]())
+	require.Equal(t, 64, BitWidth[uint64]())
+	require.Equal(t, bits.UintSize, BitWidth[uint]())
+	require.Equal(t, bits.UintSize, BitWidth[uintptr]())
+}
+
+func TestShiftSignFlip(t *testing.T) {
+	for number := range Iter[int8](math.MinInt8, math.MaxInt8) {
+		for count := range Iter[int8](0, math.MaxInt8) {
+			shifted, err := Shift(number, count)
+
+			// Shift with wrapping around that changes the sign is always detected
+			if wrapped := number << count; (wrapped < 0) != (number < 0) {
+				require.ErrorIs(t, err, ErrOverflow, "number: %v, count: %v", number, count)
+			}
+
+			// Result of the shift is the same as the multiplication by a power of
+			// two, so it has the same sign as the integer
+			reference := int64(number) * int64(math.Pow(2, float64(min(count, 16))))
+
+			if reference < math.MinInt8 || reference > math.MaxInt8 {
+				require.ErrorIs(t, err, ErrOverflow, "number: %v, count: %v", number, count)
+				continue
+			}
+
+			require.NoError(t, err, "number: %v, count: %v", number, count)
+			require.Equal(t, int8(reference), shifted, "number: %v, count: %v", number, count)
+		}
+	}
+}
+
+func TestShiftLeftStrict(t *testing.T) {
+	for number := range Iter[uint8](0, math.MaxUint8) {
+		for count := range Iter[int8](math.MinInt8, math.MaxInt8) {
+			shifted, err := ShiftLeftStrict(number, count)
+
+			switch {
+			case count < 0:
+				require.ErrorIs(t, err, ErrNegativeShift)
+			case count >= 8:
+				require.ErrorIs(t, err, ErrLargeShift)
+			default:
+				expected, expectedErr := Shift(number, count)
+				require.Equal(t, expectedErr, err)
+				require.Equal(t, expected, shifted)
+			}
+		}
+	}
+
+	_, err := ShiftLeftStrict[int64](0, 64)
+	require.ErrorIs(t, err, ErrLargeShift)
+
+	shifted, err := ShiftLeftStrict[int64](0, 63)
+	require.NoError(t, err)
+	require.Zero(t, shifted)
+
+	shifted, err = Shift[int64](0, 64)
+	require.NoError(t, err)
+	require.Zero(t, shifted)
+}
+
+func TestShiftRight(t *testing.T) {
+	for number := range Iter[int8](math.MinInt8, math.MaxInt8) {
+		for count := range Iter[uint8](0, math.MaxUint8) {
+			reference := int8(math.Floor(float64(number) / math.Pow(2, float64(min(count, 16)))))
+
+			shifted, err := ShiftRight(number, count, ShiftModeFill)
+			require.NoError(t, err)
+			require.Equal(t, reference, shifted, "number: %v, count: %v", number, count)
+
+			shifted, err = ShiftRight(number, count, ShiftModeStrict)
+			if count >= 8 {
+				require.ErrorIs(t, err, ErrLargeShift)
+				continue
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, reference, shifted, "number: %v, count: %v", number, count)
+		}
+	}
+
+	for number := range Iter[uint8](0, math.MaxUint8) {
+		for count := range Iter[int8](math.MinInt8, math.MaxInt8) {
+			shifted, err := ShiftRight(number, count, ShiftModeFill)
+			if count < 0 {
+				require.ErrorIs(t, err, ErrNegativeShift)
+				continue
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, number>>count, shifted)
+		}
+	}
+
+	shifted, err := ShiftRight[int64](-1, 1000, ShiftModeFill)
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), shifted)
+
+	_, err = ShiftRight[int64](-1, 64, ShiftModeStrict)
+	require.ErrorIs(t, err, ErrLargeShift)
+}
+
+func TestRotate(t *testing.T) {
+	for number := range Iter[uint8](0, math.MaxUint8) {
+		for count := range Iter[int8](math.MinInt8, math.MaxInt8) {
+			reference := bits.RotateLeft8(number, int(count))
+
+			require.Equal(t, reference, RotateLeft(number, count))
+			require.Equal(t, reference, RotateRight(number, -int(count)))
+			require.Equal(t, int8(reference), RotateLeft(int8(number), count))
+			require.Equal(t, int8(reference), RotateRight(int8(number), -int(count)))
+
+			if count >= 0 {
+				require.Equal(t, reference, RotateLeft(number, uint8(count)))
+				require.Equal(t, bits.RotateLeft8(number, -int(count)), RotateRight(number, uint8(count)))
+			}
+		}
+	}
+
+	for count := range Iter[int](-70, 70) {
+		require.Equal(t, bits.RotateLeft64(0x0123456789abcdef, count), RotateLeft[uint64](0x0123456789abcdef, count))
+		require.Equal(
+			t,
+			customUint16(bits.RotateLeft16(0x8001, count)),
+			RotateLeft[customUint16](0x8001, count),
+		)
+		require.Equal(
+			t,
+			int32(bits.RotateLeft32(0x80000001, -count)),
+			RotateRight(int32(math.MinInt32+1), count),
+		)
+	}
+}
+
+func BenchmarkShiftRight(b *testing.B) {
+	shifted := uint64(0)
+
+	for id := range b.N {
+		shifted, _ = ShiftRight(uint64(math.MaxUint64), id%64, ShiftModeStrict)
+	}
+
+	require.NotZero(b, shifted)
+}
+
+func BenchmarkRotateLeft(b *testing.B) {
+	rotated := uint64(0)
+
+	for id := range b.N {
+		rotated = RotateLeft(uint64(1), id)
+	}
+
+	require.NotZero(b, rotated)
+}
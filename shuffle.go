@@ -0,0 +1,115 @@
+package safe
+
+import (
+	"iter"
+	"math/bits"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Number of rounds of the Feistel network used by [Shuffled].
+const shuffleRounds = 6
+
+// Format-preserving bijection of the integers from zero to size minus one inclusive
+// based on a balanced Feistel network with cycle-walking.
+type shuffler struct {
+	// Index of the last integer, i.e. size minus one
+	last uint64
+	// Bit size of a half of the Feistel network block
+	half int
+	// Mask of a half of the Feistel network block
+	mask uint64
+	// Keys of the rounds of the Feistel network
+	keys [shuffleRounds]uint64
+}
+
+func newShuffler(last uint64, seed uint64) shuffler {
+	// Block of the Feistel network must be wide enough to contain the last integer
+	// and must have an even bit size. Half of the block is at least one bit wide
+	half := max((bits.Len64(last)+1)/2, 1)
+
+	shf := shuffler{
+		last: last,
+		half: half,
+		mask: 1<<half - 1,
+	}
+
+	for id := range shf.keys {
+		seed = splitMix64(seed)
+		shf.keys[id] = seed
+	}
+
+	return shf
+}
+
+// Returns the image of an integer that is not greater than the last one.
+func (shf shuffler) permute(number uint64) uint64 {
+	// Feistel network is a bijection of the block, so repeated application of it
+	// starting from an integer within the range sooner or later returns an integer
+	// within the range, and such a mapping is a bijection of the range. Block is
+	// at most four times larger than the range, so the expected number of
+	// repetitions does not exceed four
+	for {
+		number = shf.encrypt(number)
+
+		if number <= shf.last {
+			return number
+		}
+	}
+}
+
+func (shf shuffler) encrypt(number uint64) uint64 {
+	left := number >> shf.half
+	right := number & shf.mask
+
+	for _, key := range shf.keys {
+		left, right = right, left^(splitMix64(right^key)&shf.mask)
+	}
+
+	return left<<shf.half | right
+}
+
+// Finalizer of the SplitMix64 pseudo-random number generator, used as a mixing
+// function.
+func splitMix64(number uint64) uint64 {
+	number += 0x9e3779b97f4a7c15
+	number = (number ^ number>>30) * 0xbf58476d1ce4e5b9
+	number = (number ^ number>>27) * 0x94d049bb133111eb
+
+	return number ^ number>>31
+}
+
+// A range iterator for safely (without infinite loops due to counter overflow)
+// iterating over integer values from begin to end inclusive in pseudo-random order.
+// Each value is returned exactly once.
+//
+// The order is determined by the seed, the same seed and range give the same order.
+// The order is not cryptographically secure.
+//
+// Values are not materialized, so the iterator does not allocate memory regardless
+// of the size of the range, and works with the full range of any integer type.
+//
+// The order of begin and end does not matter.
+func Shuffled[Type constraints.Integer](begin, end Type, seed uint64) iter.Seq[Type] {
+	iterator := func(yield func(Type) bool) {
+		lower := min(begin, end)
+		last := Dist(begin, end)
+
+		shf := newShuffler(last, seed)
+
+		for id := uint64(0); ; id++ {
+			// Offset is not greater than the distance between begin and end, so the
+			// result of addition with wrapping around lies between them and is
+			// correct
+			if !yield(lower + Type(shf.permute(id))) {
+				return
+			}
+
+			if id == last {
+				return
+			}
+		}
+	}
+
+	return iterator
+}
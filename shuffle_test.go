@@ -0,0 +1,115 @@
+package safe
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/akramarenkov/intspec"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+func TestShuffled(t *testing.T) {
+	for _, seed := range []uint64{0, 1, math.MaxUint64} {
+		testShuffled(t, int8(math.MinInt8), int8(math.MaxInt8), seed)
+		testShuffled(t, uint8(0), uint8(math.MaxUint8), seed)
+		testShuffled(t, int16(math.MinInt16), int16(math.MaxInt16), seed)
+		testShuffled(t, uint16(0), uint16(math.MaxUint16), seed)
+		testShuffled(t, int16(math.MaxInt16), int16(math.MinInt16), seed)
+
+		for begin := range Iter[int8](math.MinInt8, math.MaxInt8) {
+			for _, end := range []int8{math.MinInt8, -1, 0, 1, 2, 3, 5, 100, math.MaxInt8} {
+				testShuffled(t, begin, end, seed)
+			}
+		}
+
+		for _, begin := range []int16{math.MinInt16, -1000, 0, 1, 1000, math.MaxInt16} {
+			for _, end := range []int16{math.MinInt16, -999, 0, 2, 1021, 1024, math.MaxInt16} {
+				testShuffled(t, begin, end, seed)
+			}
+		}
+	}
+}
+
+func testShuffled[Type constraints.Integer](t *testing.T, begin, end Type, seed uint64) {
+	lower, upper := min(begin, end), max(begin, end)
+
+	visited := make([]bool, IterSize(begin, end))
+	repeated := []Type(nil)
+	outside := []Type(nil)
+
+	for number := range Shuffled(begin, end, seed) {
+		if number < lower || number > upper {
+			outside = append(outside, number)
+			continue
+		}
+
+		offset := Dist(lower, number)
+
+		if visited[offset] {
+			repeated = append(repeated, number)
+		}
+
+		visited[offset] = true
+	}
+
+	require.Empty(t, outside, "begin: %v, end: %v", begin, end)
+	require.Empty(t, repeated, "begin: %v, end: %v", begin, end)
+	require.NotContains(t, visited, false, "begin: %v, end: %v", begin, end)
+}
+
+func TestShuffledOrder(t *testing.T) {
+	ordered := slices.Collect(Iter[uint16](0, math.MaxUint16))
+
+	first := slices.Collect(Shuffled[uint16](0, math.MaxUint16, 1))
+	second := slices.Collect(Shuffled[uint16](0, math.MaxUint16, 1))
+	third := slices.Collect(Shuffled[uint16](0, math.MaxUint16, 2))
+
+	require.Equal(t, first, second)
+	require.NotEqual(t, first, third)
+	require.NotEqual(t, ordered, first)
+	require.NotEqual(t, ordered, third)
+
+	// Values are not left in place too often
+	fixed := 0
+
+	for id, number := range first {
+		if ordered[id] == number {
+			fixed++
+		}
+	}
+
+	require.Less(t, fixed, 16)
+}
+
+func TestShuffledMax(t *testing.T) {
+	minimum, maximum := intspec.Range[int64]()
+
+	visited := make(map[int64]bool)
+
+	for number := range Shuffled(minimum, maximum, 0) {
+		if len(visited) == 1<<16 {
+			break
+		}
+
+		require.False(t, visited[number])
+
+		visited[number] = true
+	}
+
+	require.Len(t, visited, 1<<16)
+
+	actual := slices.Collect(Shuffled[uint64](math.MaxUint64, math.MaxUint64, 0))
+	require.Equal(t, []uint64{math.MaxUint64}, actual)
+}
+
+func BenchmarkShuffled(b *testing.B) {
+	number := uint32(0)
+
+	for value := range Shuffled[uint32](1, uint32(b.N), 0) {
+		number = value
+	}
+
+	require.NotZero(b, number)
+}
@@ -0,0 +1,553 @@
+package safe
+
+import (
+	"math/bits"
+
+	"github.com/akramarenkov/safe/internal/is"
+
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
+
+// Number of integers in a block of slices processed at once. Blocks are calculated
+// into a buffer in a simple loop without branches with accumulation of overflow flags
+// and, in the absence of overflow, are copied to the destination slice. Only blocks
+// with an overflow are recalculated integer by integer with checks. Buffer allows the
+// destination slice to be the same as the source slices.
+const sliceBlockSize = 256
+
+// Element-wise operation on slices.
+type sliceOp[Type constraints.Integer] struct {
+	// Calculates the block of slices into the buffer with wrapping around and
+	// detects whether an overflow has occurred or not
+	calc func(begin, end int, buffer []Type) bool
+	// Calculates the integer with the specified index with checks
+	checked func(id int) (Type, error)
+	// Returns the saturated integer with the specified index in case of overflow
+	saturated func(id int) Type
+}
+
+// Performs the operation and stops at the first overflow.
+func (op sliceOp[Type]) first(dst []Type) (int, error) {
+	var buffer [sliceBlockSize]Type
+
+	for begin := 0; begin < len(dst); begin += sliceBlockSize {
+		end := min(begin+sliceBlockSize, len(dst))
+
+		if !op.calc(begin, end, buffer[:end-begin]) {
+			copy(dst[begin:end], buffer[:end-begin])
+			continue
+		}
+
+		for id := begin; id < end; id++ {
+			result, err := op.checked(id)
+			if err != nil {
+				return id, err
+			}
+
+			dst[id] = result
+		}
+	}
+
+	return 0, nil
+}
+
+// Performs the operation and collects indices of all overflows.
+func (op sliceOp[Type]) all(dst []Type) ([]int, error) {
+	var (
+		buffer [sliceBlockSize]Type
+		failed []int
+	)
+
+	for begin := 0; begin < len(dst); begin += sliceBlockSize {
+		end := min(begin+sliceBlockSize, len(dst))
+
+		if !op.calc(begin, end, buffer[:end-begin]) {
+			copy(dst[begin:end], buffer[:end-begin])
+			continue
+		}
+
+		for id := begin; id < end; id++ {
+			result, err := op.checked(id)
+			if err != nil {
+				failed = append(failed, id)
+			}
+
+			dst[id] = result
+		}
+	}
+
+	if len(failed) != 0 {
+		return failed, ErrOverflow
+	}
+
+	return nil, nil
+}
+
+// Performs the operation with saturation in case of overflow.
+func (op sliceOp[Type]) saturate(dst []Type) {
+	var buffer [sliceBlockSize]Type
+
+	for begin := 0; begin < len(dst); begin += sliceBlockSize {
+		end := min(begin+sliceBlockSize, len(dst))
+
+		if !op.calc(begin, end, buffer[:end-begin]) {
+			copy(dst[begin:end], buffer[:end-begin])
+			continue
+		}
+
+		for id := begin; id < end; id++ {
+			result, err := op.checked(id)
+			if err != nil {
+				result = op.saturated(id)
+			}
+
+			dst[id] = result
+		}
+	}
+}
+
+// Checks that lengths of slices are equal.
+func checkLengths(length int, lengths ...int) error {
+	for _, other := range lengths {
+		if other != length {
+			return ErrLengthMismatch
+		}
+	}
+
+	return nil
+}
+
+// Returns an integer with only the highest bit set.
+func highestBit[Type constraints.Integer]() Type {
+	return Type(1) << (intspec.BitSize[Type]() - 1)
+}
+
+// Returns the minimum or maximum value for the type.
+func bound[Type constraints.Integer](upper bool) Type {
+	minimum, maximum := intspec.Range[Type]()
+
+	if upper {
+		return maximum
+	}
+
+	return minimum
+}
+
+func addSlicesOp[Type constraints.Integer](first, second []Type) sliceOp[Type] {
+	return sliceOp[Type]{
+		calc: func(begin, end int, buffer []Type) bool {
+			first, second := first[begin:end], second[begin:end]
+			second, buffer = second[:len(first)], buffer[:len(first)]
+
+			// Flags of overflow are accumulated without branches in the highest bit
+			var flags Type
+
+			if is.Signed[Type]() {
+				// Overflow occurs when the sign of the sum differs from the signs of
+				// both addends
+				for id, number := range first {
+					sum := number + second[id]
+					flags |= (sum ^ number) & (sum ^ second[id])
+					buffer[id] = sum
+				}
+			} else {
+				// Highest bit is equal to the carry from the highest bit
+				for id, number := range first {
+					sum := number + second[id]
+					flags |= number&second[id] | (number|second[id])&^sum
+					buffer[id] = sum
+				}
+			}
+
+			return flags&highestBit[Type]() != 0
+		},
+		checked: func(id int) (Type, error) {
+			return Add(first[id], second[id])
+		},
+		saturated: func(id int) Type {
+			return bound[Type](second[id] > 0)
+		},
+	}
+}
+
+// Adds integers of two slices element-wise, stores sums in the destination slice and
+// detects whether an overflow has occurred or not.
+//
+// Destination slice may be the same as one of the source slices, but must not
+// partially overlap them.
+//
+// In case of overflow, the index of the first integer for which it occurred and an
+// error are returned, integers of the destination slice starting from that index are
+// not modified. In case of different lengths of slices, an error is returned.
+func AddSlices[Type constraints.Integer](dst, first, second []Type) (int, error) {
+	if err := checkLengths(len(dst), len(first), len(second)); err != nil {
+		return 0, err
+	}
+
+	return addSlicesOp(first, second).first(dst)
+}
+
+// Adds integers of two slices element-wise like [AddSlices], but does not stop at
+// an overflow.
+//
+// In case of overflow, indices of all integers for which it occurred and an error
+// are returned, integers of the destination slice with these indices are set to zero.
+// In case of different lengths of slices, an error is returned.
+func AddSlicesAll[Type constraints.Integer](dst, first, second []Type) ([]int, error) {
+	if err := checkLengths(len(dst), len(first), len(second)); err != nil {
+		return nil, err
+	}
+
+	return addSlicesOp(first, second).all(dst)
+}
+
+// Adds integers of two slices element-wise like [AddSlices], but in case of overflow
+// stores the maximum or minimum value for the type, depending on the direction of the
+// overflow.
+//
+// In case of different lengths of slices, an error is returned.
+func AddSlicesSat[Type constraints.Integer](dst, first, second []Type) error {
+	if err := checkLengths(len(dst), len(first), len(second)); err != nil {
+		return err
+	}
+
+	addSlicesOp(first, second).saturate(dst)
+
+	return nil
+}
+
+func subSlicesOp[Type constraints.Integer](minuends, subtrahends []Type) sliceOp[Type] {
+	return sliceOp[Type]{
+		calc: func(begin, end int, buffer []Type) bool {
+			minuends, subtrahends := minuends[begin:end], subtrahends[begin:end]
+			subtrahends, buffer = subtrahends[:len(minuends)], buffer[:len(minuends)]
+
+			// Flags of overflow are accumulated without branches in the highest bit
+			var flags Type
+
+			if is.Signed[Type]() {
+				// Overflow occurs when the signs of the minuend and subtrahend
+				// differ and the sign of the difference differs from the sign of the
+				// minuend
+				for id, minuend := range minuends {
+					diff := minuend - subtrahends[id]
+					flags |= (minuend ^ subtrahends[id]) & (minuend ^ diff)
+					buffer[id] = diff
+				}
+			} else {
+				// Highest bit is equal to the borrow from the highest bit
+				for id, minuend := range minuends {
+					diff := minuend - subtrahends[id]
+					flags |= ^minuend&subtrahends[id] | ^(minuend^subtrahends[id])&diff
+					buffer[id] = diff
+				}
+			}
+
+			return flags&highestBit[Type]() != 0
+		},
+		checked: func(id int) (Type, error) {
+			return Sub(minuends[id], subtrahends[id])
+		},
+		saturated: func(id int) Type {
+			return bound[Type](subtrahends[id] < 0)
+		},
+	}
+}
+
+// Subtracts integers of two slices element-wise (subtrahends from minuends), stores
+// differences in the destination slice and detects whether an overflow has occurred
+// or not.
+//
+// Destination slice may be the same as one of the source slices, but must not
+// partially overlap them.
+//
+// In case of overflow, the index of the first integer for which it occurred and an
+// error are returned, integers of the destination slice starting from that index are
+// not modified. In case of different lengths of slices, an error is returned.
+func SubSlices[Type constraints.Integer](dst, minuends, subtrahends []Type) (int, error) {
+	if err := checkLengths(len(dst), len(minuends), len(subtrahends)); err != nil {
+		return 0, err
+	}
+
+	return subSlicesOp(minuends, subtrahends).first(dst)
+}
+
+// Subtracts integers of two slices element-wise like [SubSlices], but does not stop
+// at an overflow.
+//
+// In case of overflow, indices of all integers for which it occurred and an error
+// are returned, integers of the destination slice with these indices are set to zero.
+// In case of different lengths of slices, an error is returned.
+func SubSlicesAll[Type constraints.Integer](dst, minuends, subtrahends []Type) ([]int, error) {
+	if err := checkLengths(len(dst), len(minuends), len(subtrahends)); err != nil {
+		return nil, err
+	}
+
+	return subSlicesOp(minuends, subtrahends).all(dst)
+}
+
+// Subtracts integers of two slices element-wise like [SubSlices], but in case of
+// overflow stores the maximum or minimum value for the type, depending on the
+// direction of the overflow.
+//
+// In case of different lengths of slices, an error is returned.
+func SubSlicesSat[Type constraints.Integer](dst, minuends, subtrahends []Type) error {
+	if err := checkLengths(len(dst), len(minuends), len(subtrahends)); err != nil {
+		return err
+	}
+
+	subSlicesOp(minuends, subtrahends).saturate(dst)
+
+	return nil
+}
+
+// Multiplies integers of two slices element-wise into the buffer with wrapping around
+// and detects whether an overflow has occurred or not. For types with bit size not
+// greater than 32, products are calculated in 64-bit integers, for 64-bit types
+// absolute values of products are calculated in 128-bit integers, which is much
+// faster than the check by division.
+func mulWrap[Type constraints.Integer](first, second, buffer []Type) bool {
+	const maxWideningBitSize = 32
+
+	second, buffer = second[:len(first)], buffer[:len(first)]
+
+	overflow := false
+
+	switch {
+	case intspec.BitSize[Type]() > maxWideningBitSize:
+		// Flags of overflow are accumulated without branches
+		var flags uint64
+
+		// Absolute value of the product must fit into 63 bits for signed types,
+		// and a negative product may be greater by one in absolute value
+		limit := uint64(bound[Type](true))
+
+		for id, number := range first {
+			high, low := bits.Mul64(Abs(number), Abs(second[id]))
+
+			var negative uint64
+
+			if (number < 0) != (second[id] < 0) && low != 0 {
+				negative = 1
+			}
+
+			flags |= high | (low-negative)&^limit
+			buffer[id] = number * second[id]
+		}
+
+		overflow = flags != 0
+	case is.Signed[Type]():
+		for id, number := range first {
+			product := int64(number) * int64(second[id])
+
+			overflow = overflow || int64(Type(product)) != product
+			buffer[id] = Type(product)
+		}
+	default:
+		for id, number := range first {
+			product := uint64(number) * uint64(second[id])
+
+			overflow = overflow || uint64(Type(product)) != product
+			buffer[id] = Type(product)
+		}
+	}
+
+	return overflow
+}
+
+func mulSlicesOp[Type constraints.Integer](first, second []Type) sliceOp[Type] {
+	return sliceOp[Type]{
+		calc: func(begin, end int, buffer []Type) bool {
+			return mulWrap(first[begin:end], second[begin:end], buffer)
+		},
+		checked: func(id int) (Type, error) {
+			return Mul(first[id], second[id])
+		},
+		saturated: func(id int) Type {
+			return bound[Type]((first[id] < 0) == (second[id] < 0))
+		},
+	}
+}
+
+// Multiplies integers of two slices element-wise, stores products in the destination
+// slice and detects whether an overflow has occurred or not.
+//
+// Destination slice may be the same as one of the source slices, but must not
+// partially overlap them.
+//
+// In case of overflow, the index of the first integer for which it occurred and an
+// error are returned, integers of the destination slice starting from that index are
+// not modified. In case of different lengths of slices, an error is returned.
+func MulSlices[Type constraints.Integer](dst, first, second []Type) (int, error) {
+	if err := checkLengths(len(dst), len(first), len(second)); err != nil {
+		return 0, err
+	}
+
+	return mulSlicesOp(first, second).first(dst)
+}
+
+// Multiplies integers of two slices element-wise like [MulSlices], but does not stop
+// at an overflow.
+//
+// In case of overflow, indices of all integers for which it occurred and an error
+// are returned, integers of the destination slice with these indices are set to zero.
+// In case of different lengths of slices, an error is returned.
+func MulSlicesAll[Type constraints.Integer](dst, first, second []Type) ([]int, error) {
+	if err := checkLengths(len(dst), len(first), len(second)); err != nil {
+		return nil, err
+	}
+
+	return mulSlicesOp(first, second).all(dst)
+}
+
+// Multiplies integers of two slices element-wise like [MulSlices], but in case of
+// overflow stores the maximum or minimum value for the type, depending on the sign of
+// the product.
+//
+// In case of different lengths of slices, an error is returned.
+func MulSlicesSat[Type constraints.Integer](dst, first, second []Type) error {
+	if err := checkLengths(len(dst), len(first), len(second)); err != nil {
+		return err
+	}
+
+	mulSlicesOp(first, second).saturate(dst)
+
+	return nil
+}
+
+func scaleSliceOp[Type constraints.Integer](numbers []Type, factor Type) sliceOp[Type] {
+	// Factor is repeated to multiply blocks element-wise
+	var factors [sliceBlockSize]Type
+
+	for id := range factors {
+		factors[id] = factor
+	}
+
+	return sliceOp[Type]{
+		calc: func(begin, end int, buffer []Type) bool {
+			return mulWrap(numbers[begin:end], factors[:end-begin], buffer)
+		},
+		checked: func(id int) (Type, error) {
+			return Mul(numbers[id], factor)
+		},
+		saturated: func(id int) Type {
+			return bound[Type]((numbers[id] < 0) == (factor < 0))
+		},
+	}
+}
+
+// Multiplies integers of a slice by a factor, stores products in the destination
+// slice and detects whether an overflow has occurred or not.
+//
+// Destination slice may be the same as the source slice, but must not partially
+// overlap it.
+//
+// In case of overflow, the index of the first integer for which it occurred and an
+// error are returned, integers of the destination slice starting from that index are
+// not modified. In case of different lengths of slices, an error is returned.
+func ScaleSlice[Type constraints.Integer](dst, numbers []Type, factor Type) (int, error) {
+	if err := checkLengths(len(dst), len(numbers)); err != nil {
+		return 0, err
+	}
+
+	return scaleSliceOp(numbers, factor).first(dst)
+}
+
+// Multiplies integers of a slice by a factor like [ScaleSlice], but does not stop at
+// an overflow.
+//
+// In case of overflow, indices of all integers for which it occurred and an error
+// are returned, integers of the destination slice with these indices are set to zero.
+// In case of different lengths of slices, an error is returned.
+func ScaleSliceAll[Type constraints.Integer](dst, numbers []Type, factor Type) ([]int, error) {
+	if err := checkLengths(len(dst), len(numbers)); err != nil {
+		return nil, err
+	}
+
+	return scaleSliceOp(numbers, factor).all(dst)
+}
+
+// Multiplies integers of a slice by a factor like [ScaleSlice], but in case of
+// overflow stores the maximum or minimum value for the type, depending on the sign of
+// the product.
+//
+// In case of different lengths of slices, an error is returned.
+func ScaleSliceSat[Type constraints.Integer](dst, numbers []Type, factor Type) error {
+	if err := checkLengths(len(dst), len(numbers)); err != nil {
+		return err
+	}
+
+	scaleSliceOp(numbers, factor).saturate(dst)
+
+	return nil
+}
+
+func convertSliceOp[TypeTo, TypeFrom constraints.Integer](src []TypeFrom) sliceOp[TypeTo] {
+	return sliceOp[TypeTo]{
+		calc: func(begin, end int, buffer []TypeTo) bool {
+			src := src[begin:end]
+			buffer = buffer[:len(src)]
+
+			overflow := false
+
+			for id, number := range src {
+				converted := TypeTo(number)
+
+				// Conversion changes the sign or the value
+				overflow = overflow || (converted < 0) != (number < 0) || TypeFrom(converted) != number
+				buffer[id] = converted
+			}
+
+			return overflow
+		},
+		checked: func(id int) (TypeTo, error) {
+			return IToI[TypeTo](src[id])
+		},
+		saturated: func(id int) TypeTo {
+			return bound[TypeTo](src[id] > 0)
+		},
+	}
+}
+
+// Converts integers of a slice of one type to integers of another type, stores them
+// in the destination slice and detects whether an overflow has occurred or not.
+//
+// In case of overflow, the index of the first integer for which it occurred and an
+// error are returned, integers of the destination slice starting from that index are
+// not modified. In case of different lengths of slices, an error is returned.
+func ConvertSlice[TypeTo, TypeFrom constraints.Integer](dst []TypeTo, src []TypeFrom) (int, error) {
+	if err := checkLengths(len(dst), len(src)); err != nil {
+		return 0, err
+	}
+
+	return convertSliceOp[TypeTo](src).first(dst)
+}
+
+// Converts integers of a slice like [ConvertSlice], but does not stop at an overflow.
+//
+// In case of overflow, indices of all integers for which it occurred and an error
+// are returned, integers of the destination slice with these indices are set to zero.
+// In case of different lengths of slices, an error is returned.
+func ConvertSliceAll[TypeTo, TypeFrom constraints.Integer](dst []TypeTo, src []TypeFrom) ([]int, error) {
+	if err := checkLengths(len(dst), len(src)); err != nil {
+		return nil, err
+	}
+
+	return convertSliceOp[TypeTo](src).all(dst)
+}
+
+// Converts integers of a slice like [ConvertSlice], but in case of overflow stores
+// the maximum or minimum value for the destination type, depending on the sign of the
+// converted integer.
+//
+// In case of different lengths of slices, an error is returned.
+func ConvertSliceSat[TypeTo, TypeFrom constraints.Integer](dst []TypeTo, src []TypeFrom) error {
+	if err := checkLengths(len(dst), len(src)); err != nil {
+		return err
+	}
+
+	convertSliceOp[TypeTo](src).saturate(dst)
+
+	return nil
+}
@@ -0,0 +1,470 @@
+package safe
+
+import (
+	"math"
+	"testing"
+
+	"github.com/akramarenkov/intspec"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+// Returns slices containing all pairs of integers of the type.
+func slicePairs[Type constraints.Integer]() ([]Type, []Type) {
+	first := make([]Type, 0, 1<<16)
+	second := make([]Type, 0, 1<<16)
+
+	for number := range Iter(intspec.Range[Type]()) {
+		for other := range Iter(intspec.Range[Type]()) {
+			first = append(first, number)
+			second = append(second, other)
+		}
+	}
+
+	return first, second
+}
+
+func clamp[Type constraints.Integer](reference int64) Type {
+	minimum, maximum := intspec.Range[Type]()
+
+	return Type(max(min(reference, int64(maximum)), int64(minimum)))
+}
+
+func TestSlicesOps(t *testing.T) {
+	testSlicesOps[int8](t)
+	testSlicesOps[uint8](t)
+}
+
+func TestSlicesOps64(t *testing.T) {
+	testSlicesOps64(t, []int64{math.MinInt64, math.MinInt64 + 1, -1 << 32, -1, 0, 1, 2, 1 << 32, math.MaxInt64})
+	testSlicesOps64(t, []uint64{0, 1, 2, 3, 1 << 32, 1<<32 + 1, 1 << 63, math.MaxUint64 - 1, math.MaxUint64})
+}
+
+func testSlicesOps64[Type constraints.Integer](t *testing.T, dataset []Type) {
+	first := []Type(nil)
+	second := []Type(nil)
+
+	for _, number := range dataset {
+		for _, other := range dataset {
+			first = append(first, number)
+			second = append(second, other)
+		}
+	}
+
+	for _, op := range []struct {
+		operation    func(dst, first, second []Type) (int, error)
+		operationAll func(dst, first, second []Type) ([]int, error)
+		operationSat func(dst, first, second []Type) error
+		scalar       func(first, second Type) (Type, error)
+	}{
+		{AddSlices[Type], AddSlicesAll[Type], AddSlicesSat[Type], Add[Type]},
+		{SubSlices[Type], SubSlicesAll[Type], SubSlicesSat[Type], Sub[Type]},
+		{MulSlices[Type], MulSlicesAll[Type], MulSlicesSat[Type], Mul[Type]},
+	} {
+		expected := make([]Type, len(first))
+		expectedFailed := []int(nil)
+
+		for id := range first {
+			result, err := op.scalar(first[id], second[id])
+			if err != nil {
+				expectedFailed = append(expectedFailed, id)
+			}
+
+			expected[id] = result
+		}
+
+		dst := make([]Type, len(first))
+
+		index, err := op.operation(dst, first, second)
+		require.ErrorIs(t, err, ErrOverflow)
+		require.Equal(t, expectedFailed[0], index)
+		require.Equal(t, expected[:index], dst[:index])
+
+		failed, err := op.operationAll(dst, first, second)
+		require.ErrorIs(t, err, ErrOverflow)
+		require.Equal(t, expectedFailed, failed)
+		require.Equal(t, expected, dst)
+
+		require.NoError(t, op.operationSat(dst, first, second))
+
+		for _, id := range expectedFailed {
+			minimum, maximum := intspec.Range[Type]()
+			require.Contains(t, []Type{minimum, maximum}, dst[id])
+		}
+	}
+}
+
+func testSlicesOps[Type constraints.Integer](t *testing.T) {
+	first, second := slicePairs[Type]()
+
+	testSlicesOp(t, first, second, AddSlices, AddSlicesAll, AddSlicesSat, Add, func(first, second int64) int64 {
+		return first + second
+	})
+
+	testSlicesOp(t, first, second, SubSlices, SubSlicesAll, SubSlicesSat, Sub, func(first, second int64) int64 {
+		return first - second
+	})
+
+	testSlicesOp(t, first, second, MulSlices, MulSlicesAll, MulSlicesSat, Mul, func(first, second int64) int64 {
+		return first * second
+	})
+}
+
+func testSlicesOp[Type constraints.Integer](
+	t *testing.T,
+	first []Type,
+	second []Type,
+	operation func(dst, first, second []Type) (int, error),
+	operationAll func(dst, first, second []Type) ([]int, error),
+	operationSat func(dst, first, second []Type) error,
+	scalar func(first, second Type) (Type, error),
+	reference func(first, second int64) int64,
+) {
+	expected := make([]Type, len(first))
+	expectedSat := make([]Type, len(first))
+	expectedFailed := []int(nil)
+
+	for id := range first {
+		result, err := scalar(first[id], second[id])
+		if err != nil {
+			expectedFailed = append(expectedFailed, id)
+		}
+
+		expected[id] = result
+		expectedSat[id] = clamp[Type](reference(int64(first[id]), int64(second[id])))
+	}
+
+	require.NotEmpty(t, expectedFailed)
+
+	// Operation stops at the first overflow
+	dst := make([]Type, len(first))
+
+	index, err := operation(dst, first, second)
+	require.ErrorIs(t, err, ErrOverflow)
+	require.Equal(t, expectedFailed[0], index)
+	require.Equal(t, expected[:index], dst[:index])
+	require.Equal(t, make([]Type, len(dst)-index), dst[index:])
+
+	// Operation on slices without overflow
+	begin := 0
+
+	for _, failed := range expectedFailed {
+		end := failed
+
+		index, err := operation(dst[begin:end], first[begin:end], second[begin:end])
+		require.NoError(t, err)
+		require.Zero(t, index)
+		require.Equal(t, expected[begin:end], dst[begin:end])
+
+		begin = failed + 1
+	}
+
+	dst = make([]Type, len(first))
+
+	failed, err := operationAll(dst, first, second)
+	require.ErrorIs(t, err, ErrOverflow)
+	require.Equal(t, expectedFailed, failed)
+	require.Equal(t, expected, dst)
+
+	dst = make([]Type, len(first))
+
+	require.NoError(t, operationSat(dst, first, second))
+	require.Equal(t, expectedSat, dst)
+}
+
+func TestScaleSlice(t *testing.T) {
+	testScaleSlice[int8](t)
+	testScaleSlice[uint8](t)
+}
+
+func testScaleSlice[Type constraints.Integer](t *testing.T) {
+	numbers := make([]Type, 0, 1<<8)
+
+	for number := range Iter(intspec.Range[Type]()) {
+		numbers = append(numbers, number)
+	}
+
+	for factor := range Iter(intspec.Range[Type]()) {
+		expected := make([]Type, len(numbers))
+		expectedSat := make([]Type, len(numbers))
+		expectedFailed := []int(nil)
+
+		for id, number := range numbers {
+			product, err := Mul(number, factor)
+			if err != nil {
+				expectedFailed = append(expectedFailed, id)
+			}
+
+			expected[id] = product
+			expectedSat[id] = clamp[Type](int64(number) * int64(factor))
+		}
+
+		dst := make([]Type, len(numbers))
+
+		index, err := ScaleSlice(dst, numbers, factor)
+		if len(expectedFailed) == 0 {
+			require.NoError(t, err)
+			require.Zero(t, index)
+			require.Equal(t, expected, dst)
+		} else {
+			require.ErrorIs(t, err, ErrOverflow)
+			require.Equal(t, expectedFailed[0], index)
+			require.Equal(t, expected[:index], dst[:index])
+		}
+
+		failed, err := ScaleSliceAll(dst, numbers, factor)
+		require.Equal(t, len(expectedFailed) == 0, err == nil)
+		require.Equal(t, expectedFailed, failed)
+		require.Equal(t, expected, dst)
+
+		require.NoError(t, ScaleSliceSat(dst, numbers, factor))
+		require.Equal(t, expectedSat, dst)
+	}
+}
+
+func TestConvertSlice(t *testing.T) {
+	testConvertSlice[int8, uint8](t)
+	testConvertSlice[uint8, int8](t)
+	testConvertSlice[int8, int16](t)
+	testConvertSlice[uint8, int16](t)
+	testConvertSlice[uint8, uint16](t)
+	testConvertSlice[int16, int8](t)
+	testConvertSlice[uint16, int8](t)
+	testConvertSlice[int64, uint64](t)
+	testConvertSlice[uint64, int64](t)
+}
+
+func testConvertSlice[TypeTo, TypeFrom constraints.Integer](t *testing.T) {
+	src := []TypeFrom(nil)
+
+	if intspec.BitSize[TypeFrom]() <= 16 { //nolint:mnd // Exhaustive only for small types
+		for number := range Iter(intspec.Range[TypeFrom]()) {
+			src = append(src, number)
+		}
+	} else {
+		minimum, maximum := intspec.Range[TypeFrom]()
+
+		src = append(src, minimum, minimum+1, 0, 1, maximum-1, maximum)
+	}
+
+	expected := make([]TypeTo, len(src))
+	expectedSat := make([]TypeTo, len(src))
+	expectedFailed := []int(nil)
+
+	minimum, maximum := intspec.Range[TypeTo]()
+
+	for id, number := range src {
+		converted, err := IToI[TypeTo](number)
+		if err != nil {
+			expectedFailed = append(expectedFailed, id)
+
+			expectedSat[id] = minimum
+
+			if number > 0 {
+				expectedSat[id] = maximum
+			}
+		} else {
+			expectedSat[id] = converted
+		}
+
+		expected[id] = converted
+	}
+
+	dst := make([]TypeTo, len(src))
+
+	index, err := ConvertSlice(dst, src)
+
+	if len(expectedFailed) == 0 {
+		require.NoError(t, err)
+		require.Zero(t, index)
+		require.Equal(t, expected, dst)
+	} else {
+		require.ErrorIs(t, err, ErrOverflow)
+		require.Equal(t, expectedFailed[0], index)
+		require.Equal(t, expected[:index], dst[:index])
+	}
+
+	failed, err := ConvertSliceAll(dst, src)
+	require.Equal(t, len(expectedFailed) == 0, err == nil)
+	require.Equal(t, expectedFailed, failed)
+	require.Equal(t, expected, dst)
+
+	require.NoError(t, ConvertSliceSat(dst, src))
+	require.Equal(t, expectedSat, dst)
+}
+
+func TestSlicesInPlace(t *testing.T) {
+	numbers := []int8{1, 2, 3, math.MaxInt8}
+
+	index, err := AddSlices(numbers, numbers, []int8{1, 1, 1, 1})
+	require.ErrorIs(t, err, ErrOverflow)
+	require.Equal(t, 3, index)
+	require.Equal(t, []int8{2, 3, 4, math.MaxInt8}, numbers)
+
+	require.NoError(t, SubSlicesSat(numbers, numbers, []int8{-1, 1, 1, -1}))
+	require.Equal(t, []int8{3, 2, 3, math.MaxInt8}, numbers)
+
+	require.NoError(t, ScaleSliceSat(numbers, numbers, -2))
+	require.Equal(t, []int8{-6, -4, -6, math.MinInt8}, numbers)
+
+	numbers64 := []int64{1, math.MaxInt64, math.MinInt64, -1}
+
+	failed, err := MulSlicesAll(numbers64, numbers64, []int64{math.MinInt64, 2, -1, math.MinInt64})
+	require.ErrorIs(t, err, ErrOverflow)
+	require.Equal(t, []int{1, 2, 3}, failed)
+	require.Equal(t, []int64{math.MinInt64, 0, 0, 0}, numbers64)
+}
+
+func TestSlicesError(t *testing.T) {
+	_, err := AddSlices(make([]int8, 2), make([]int8, 2), make([]int8, 3))
+	require.ErrorIs(t, err, ErrLengthMismatch)
+
+	_, err = SubSlicesAll(make([]int8, 3), make([]int8, 2), make([]int8, 3))
+	require.ErrorIs(t, err, ErrLengthMismatch)
+
+	err = MulSlicesSat(make([]int8, 2), make([]int8, 3), make([]int8, 3))
+	require.ErrorIs(t, err, ErrLengthMismatch)
+
+	_, err = ScaleSlice(make([]int8, 2), make([]int8, 3), 1)
+	require.ErrorIs(t, err, ErrLengthMismatch)
+
+	_, err = ConvertSlice(make([]int8, 2), make([]int16, 3))
+	require.ErrorIs(t, err, ErrLengthMismatch)
+
+	index, err := AddSlices([]int8{}, []int8{}, []int8{})
+	require.NoError(t, err)
+	require.Zero(t, index)
+}
+
+const benchmarkSliceLength = 1 << 14
+
+func benchmarkSlices[Type constraints.Integer]() ([]Type, []Type, []Type) {
+	dst := make([]Type, benchmarkSliceLength)
+	first := make([]Type, benchmarkSliceLength)
+	second := make([]Type, benchmarkSliceLength)
+
+	for id := range first {
+		first[id] = Type(id % 100)
+		second[id] = Type(id % 10)
+	}
+
+	return dst, first, second
+}
+
+func BenchmarkAddSlicesReference(b *testing.B) {
+	dst, first, second := benchmarkSlices[int32]()
+
+	for range b.N {
+		for id := range dst {
+			sum, err := Add(first[id], second[id])
+			if err != nil {
+				require.NoError(b, err)
+			}
+
+			dst[id] = sum
+		}
+	}
+
+	require.NotZero(b, dst[benchmarkSliceLength-1])
+}
+
+func BenchmarkAddSlices(b *testing.B) {
+	dst, first, second := benchmarkSlices[int32]()
+
+	for range b.N {
+		if _, err := AddSlices(dst, first, second); err != nil {
+			require.NoError(b, err)
+		}
+	}
+
+	require.NotZero(b, dst[benchmarkSliceLength-1])
+}
+
+func BenchmarkMulSlicesReference(b *testing.B) {
+	dst, first, second := benchmarkSlices[int32]()
+
+	for range b.N {
+		for id := range dst {
+			product, err := Mul(first[id], second[id])
+			if err != nil {
+				require.NoError(b, err)
+			}
+
+			dst[id] = product
+		}
+	}
+
+	require.NotZero(b, dst[benchmarkSliceLength-1])
+}
+
+func BenchmarkMulSlices(b *testing.B) {
+	dst, first, second := benchmarkSlices[int32]()
+
+	for range b.N {
+		if _, err := MulSlices(dst, first, second); err != nil {
+			require.NoError(b, err)
+		}
+	}
+
+	require.NotZero(b, dst[benchmarkSliceLength-1])
+}
+
+func BenchmarkMulSlices64Reference(b *testing.B) {
+	dst, first, second := benchmarkSlices[int64]()
+
+	for range b.N {
+		for id := range dst {
+			product, err := Mul(first[id], second[id])
+			if err != nil {
+				require.NoError(b, err)
+			}
+
+			dst[id] = product
+		}
+	}
+
+	require.NotZero(b, dst[benchmarkSliceLength-1])
+}
+
+func BenchmarkMulSlices64(b *testing.B) {
+	dst, first, second := benchmarkSlices[int64]()
+
+	for range b.N {
+		if _, err := MulSlices(dst, first, second); err != nil {
+			require.NoError(b, err)
+		}
+	}
+
+	require.NotZero(b, dst[benchmarkSliceLength-1])
+}
+
+func BenchmarkConvertSliceReference(b *testing.B) {
+	_, src, _ := benchmarkSlices[int64]()
+	dst := make([]int32, len(src))
+
+	for range b.N {
+		for id, number := range src {
+			converted, err := IToI[int32](number)
+			if err != nil {
+				require.NoError(b, err)
+			}
+
+			dst[id] = converted
+		}
+	}
+
+	require.NotZero(b, dst[benchmarkSliceLength-1])
+}
+
+func BenchmarkConvertSlice(b *testing.B) {
+	_, src, _ := benchmarkSlices[int64]()
+	dst := make([]int32, len(src))
+
+	for range b.N {
+		if _, err := ConvertSlice(dst, src); err != nil {
+			require.NoError(b, err)
+		}
+	}
+
+	require.NotZero(b, dst[benchmarkSliceLength-1])
+}
@@ -1,6 +1,15 @@
 package safe
 
-import "golang.org/x/exp/constraints"
+import (
+	"math/bits"
+	"slices"
+
+	"github.com/akramarenkov/safe/internal/clone"
+	"github.com/akramarenkov/safe/internal/wide"
+
+	"github.com/akramarenkov/intspec"
+	"golang.org/x/exp/constraints"
+)
 
 // Converts absolute value of an integer of specified type to an integer of uint64 type.
 func Abs[Type constraints.Integer](number Type) uint64 {
@@ -28,3 +37,413 @@ func Dist[Type constraints.Integer](first, second Type) uint64 {
 
 	return secondU64 - firstU64
 }
+
+// Used to safely (using a method that avoids integer overflow) calculate the midpoint
+// between two numbers.
+//
+// If the distance between the numbers is odd, the midpoint is rounded down, i.e.
+// towards negative infinity, regardless of the order of the arguments.
+func Midpoint[Type constraints.Integer](first, second Type) Type {
+	// Half of the distance does not exceed the distance between the numbers, so the
+	// result of addition with wrapping around lies between them and is correct
+	return min(first, second) + Type(Dist(first, second)/2)
+}
+
+// Calculates the arithmetic mean of two integers without overflow. The mean is
+// rounded down, i.e. towards negative infinity, as in the [Midpoint] function.
+func MeanOfTwo[Type constraints.Integer](first, second Type) Type {
+	return Midpoint(first, second)
+}
+
+// Calculates the arithmetic mean of several integers. The mean is rounded down, i.e.
+// towards negative infinity, as in the [MeanOfTwo] and [MeanSeq] functions.
+//
+// Integers are summed up in a 128-bit accumulator, so an overflow does not occur for
+// any number of integers.
+//
+// In case of missing arguments, an error is returned.
+func Mean[Type constraints.Integer](numbers ...Type) (Type, error) {
+	return mean(numbers, false)
+}
+
+// Calculates the arithmetic mean of several integers. The mean is rounded to the
+// nearest integer, halves are rounded away from zero.
+//
+// Integers are summed up in a 128-bit accumulator, so an overflow does not occur for
+// any number of integers.
+//
+// In case of missing arguments, an error is returned.
+func MeanRound[Type constraints.Integer](numbers ...Type) (Type, error) {
+	return mean(numbers, true)
+}
+
+func mean[Type constraints.Integer](numbers []Type, round bool) (Type, error) {
+	if len(numbers) == 0 {
+		return 0, ErrMissingArguments
+	}
+
+	sum := wide.Int{}
+
+	for _, number := range numbers {
+		// Number of integers in a slice is limited by the amount of memory and is
+		// much less than 2^63, so the accumulator does not overflow
+		sum, _ = sum.Add(toWide(number))
+	}
+
+	return quoWide[Type](sum, uint64(len(numbers)), round)
+}
+
+// Calculates the weighted arithmetic mean of several integers. The mean is rounded
+// down, i.e. towards negative infinity.
+//
+// Products of integers and weights are summed up in a 128-bit accumulator, so an
+// overflow occurs only if the absolute value of the sum of the products exceeds
+// 2^127-1 or the sum of the weights exceeds the maximum value for uint64.
+//
+// In case of missing arguments, different lengths of integers and weights, zero sum of
+// the weights or overflow, an error is returned.
+func WeightedMean[Type constraints.Integer](numbers []Type, weights []uint64) (Type, error) {
+	if len(numbers) != len(weights) {
+		return 0, ErrLengthMismatch
+	}
+
+	if len(numbers) == 0 {
+		return 0, ErrMissingArguments
+	}
+
+	sum := wide.Int{}
+	total := uint64(0)
+
+	for id, number := range numbers {
+		product, ok := wide.Mul(number < 0, Abs(number), weights[id])
+		if !ok {
+			return 0, ErrOverflow
+		}
+
+		if sum, ok = sum.Add(product); !ok {
+			return 0, ErrOverflow
+		}
+
+		interim, err := AddU(total, weights[id])
+		if err != nil {
+			return 0, err
+		}
+
+		total = interim
+	}
+
+	if total == 0 {
+		return 0, ErrDivisionByZero
+	}
+
+	return quoWide[Type](sum, total, false)
+}
+
+// Divides a 128-bit integer by a divisor with rounding down or rounding to the
+// nearest integer with halves rounded away from zero, converts the quotient to an
+// integer of specified type and detects whether an overflow has occurred or not.
+//
+// In case of overflow, an error is returned.
+func quoWide[Type constraints.Integer](dividend wide.Int, divisor uint64, round bool) (Type, error) {
+	quotient, remainder := dividend.QuoRem(divisor)
+
+	if remainder == 0 {
+		return fromWide[Type](quotient)
+	}
+
+	// Quotient is truncated towards zero, so it is corrected by one away from zero
+	// when rounding halves and by one towards negative infinity when rounding down.
+	// Absolute value of the quotient is less than the absolute value of the dividend,
+	// so the correction does not overflow
+	switch {
+	case round && remainder >= divisor-remainder:
+		quotient, _ = quotient.Add(wide.New(dividend.Negative(), 1))
+	case !round && dividend.Negative():
+		quotient, _ = quotient.Add(wide.New(true, 1))
+	}
+
+	return fromWide[Type](quotient)
+}
+
+// Calculates the population variance of several integers, i.e. the mean of squared
+// deviations of integers from their mean. The variance is rounded down.
+//
+// Calculation is exact, squared deviations are summed up with 128-bit precision.
+//
+// In case of missing arguments or overflow, an error is returned.
+func Variance[Type constraints.Integer](numbers ...Type) (uint64, error) {
+	high, low, err := variance(numbers)
+	if err != nil {
+		return 0, err
+	}
+
+	if high != 0 {
+		return 0, ErrOverflow
+	}
+
+	return low, nil
+}
+
+// Calculates the population standard deviation of several integers, i.e. the square
+// root of the population variance. The standard deviation is rounded down.
+//
+// Overflow is impossible, the variance is calculated with 128-bit precision, so the
+// standard deviation is calculated even if the variance does not fit into uint64.
+//
+// In case of missing arguments, an error is returned.
+func StdDev[Type constraints.Integer](numbers ...Type) (uint64, error) {
+	high, low, err := variance(numbers)
+	if err != nil {
+		return 0, err
+	}
+
+	// Square root of the rounded down variance rounded down is equal to the
+	// square root of the exact variance rounded down
+	return sqrt(high, low), nil
+}
+
+// Calculates the population variance of several integers rounded down as a 128-bit
+// unsigned integer represented by its high and low parts.
+//
+// Variance does not exceed the square of half the distance between the minimum and
+// maximum integers, i.e. 2^126, so it always fits into 128 bits.
+func variance[Type constraints.Integer](numbers []Type) (uint64, uint64, error) {
+	if len(numbers) == 0 {
+		return 0, 0, ErrMissingArguments
+	}
+
+	count := uint64(len(numbers))
+
+	sum := wide.Int{}
+
+	for _, number := range numbers {
+		// Number of integers in a slice is limited by the amount of memory and is
+		// much less than 2^63, so the accumulator does not overflow
+		sum, _ = sum.Add(toWide(number))
+	}
+
+	// Mean is equal to floor+residue/count, where floor is the mean rounded down and
+	// residue is not less than zero and less than count
+	quotient, residue := sum.QuoRem(count)
+
+	if sum.Negative() && residue != 0 {
+		quotient, _ = quotient.Add(wide.New(true, 1))
+		residue = count - residue
+	}
+
+	// Mean of integers always fits into the type
+	floor, err := fromWide[Type](quotient)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Sum of squared deviations may exceed 128 bits, so each squared deviation is
+	// divided by count, quotients are summed up and remainders are accumulated
+	// modulo count with carrying into the sum of quotients. Sum of quotients does not
+	// exceed the sum of squared deviations divided by count, i.e. the variance plus
+	// one, so it does not overflow
+	var high, low, remainder uint64
+
+	for _, number := range numbers {
+		deviation := Dist(number, floor)
+		deviationHigh, deviationLow := bits.Mul64(deviation, deviation)
+
+		quotientHigh := deviationHigh / count
+		quotientLow, partial := bits.Div64(deviationHigh%count, deviationLow, count)
+
+		// Remainders are less than count, and count is much less than 2^63, so
+		// their sum does not overflow
+		remainder += partial
+
+		carry := uint64(0)
+
+		if remainder >= count {
+			remainder -= count
+			carry = 1
+		}
+
+		low, carry = bits.Add64(low, quotientLow, carry)
+		high, _ = bits.Add64(high, quotientHigh, carry)
+	}
+
+	// Variance is equal to sum/count - (residue/count)^2, where sum is the sum of
+	// squared deviations from the rounded down mean. If sum is equal to
+	// high:low*count+remainder, then the variance is equal to
+	// high:low + (remainder*count - residue^2)/count^2, where the second term is
+	// greater than -1 and less than 1
+	productHigh, productLow := bits.Mul64(remainder, count)
+	squareHigh, squareLow := bits.Mul64(residue, residue)
+
+	if productHigh < squareHigh || productHigh == squareHigh && productLow < squareLow {
+		var borrow uint64
+
+		low, borrow = bits.Sub64(low, 1, 0)
+		high -= borrow
+	}
+
+	return high, low, nil
+}
+
+// Calculates the square root of a 128-bit unsigned integer represented by its high
+// and low parts rounded down. The square root of any 128-bit integer fits into
+// uint64.
+func sqrt(high, low uint64) uint64 {
+	root := uint64(0)
+
+	// Bits of the root are determined one by one, starting from the most
+	// significant one
+	for bit := intspec.BitSize64 - 1; bit >= 0; bit-- {
+		candidate := root | 1<<bit
+
+		squareHigh, squareLow := bits.Mul64(candidate, candidate)
+
+		if squareHigh < high || squareHigh == high && squareLow <= low {
+			root = candidate
+		}
+	}
+
+	return root
+}
+
+// Calculates the median of several integers. If the number of integers is even, then
+// the median is the mean of two middle integers rounded down, as in the [MeanOfTwo]
+// function.
+//
+// Integers are selected in a copy of the slice, so the original slice is not
+// modified.
+//
+// In case of missing arguments, an error is returned.
+func Median[Type constraints.Integer](numbers ...Type) (Type, error) {
+	if len(numbers) == 0 {
+		return 0, ErrMissingArguments
+	}
+
+	numbers = clone.Slice(numbers)
+
+	middle := len(numbers) / 2
+
+	upper := selectNth(numbers, middle)
+
+	if len(numbers)%2 != 0 {
+		return upper, nil
+	}
+
+	// After selection all integers before the selected one are not greater than it
+	lower := slices.Max(numbers[:middle])
+
+	return MeanOfTwo(lower, upper), nil
+}
+
+// Calculates the percentile of several integers using the nearest-rank method, i.e.
+// returns the smallest integer such that at least the specified percent of integers
+// are not greater than it. Zero percentile is the minimum integer, 100th percentile is
+// the maximum integer.
+//
+// Integers are selected in a copy of the slice, so the original slice is not
+// modified.
+//
+// In case of missing arguments or percentile greater than 100, an error is returned.
+func Percentile[Type constraints.Integer](percentile uint64, numbers ...Type) (Type, error) {
+	const hundred = 100
+
+	if percentile > hundred {
+		return 0, ErrPercentileRange
+	}
+
+	if len(numbers) == 0 {
+		return 0, ErrMissingArguments
+	}
+
+	// Rank is equal to ceil(percentile*count/100), where the product is calculated
+	// in 128 bits to avoid overflow
+	high, low := bits.Mul64(percentile, uint64(len(numbers)))
+	rank, remainder := bits.Div64(high, low, hundred)
+
+	if remainder != 0 {
+		rank++
+	}
+
+	// Rank of zero percentile is zero, but it corresponds to the minimum integer
+	rank = max(rank, 1)
+
+	return selectNth(clone.Slice(numbers), int(rank-1)), nil
+}
+
+// Rearranges integers so that the integer with the specified index is the same as in
+// the sorted slice, and all integers before it are not greater than it, and returns
+// it. Quickselect algorithm with three-way partitioning is used.
+func selectNth[Type constraints.Integer](numbers []Type, nth int) Type {
+	for len(numbers) > 1 {
+		pivot := medianOfThree(numbers[0], numbers[len(numbers)/2], numbers[len(numbers)-1])
+
+		// Integers are partitioned into less than the pivot, equal to it and
+		// greater than it
+		less, greater := 0, len(numbers)
+
+		for id := 0; id < greater; {
+			switch {
+			case numbers[id] < pivot:
+				numbers[less], numbers[id] = numbers[id], numbers[less]
+				less++
+				id++
+			case numbers[id] > pivot:
+				greater--
+				numbers[greater], numbers[id] = numbers[id], numbers[greater]
+			default:
+				id++
+			}
+		}
+
+		switch {
+		case nth < less:
+			numbers = numbers[:less]
+		case nth >= greater:
+			numbers = numbers[greater:]
+			nth -= greater
+		default:
+			return pivot
+		}
+	}
+
+	return numbers[0]
+}
+
+// Returns the middle of three integers.
+func medianOfThree[Type constraints.Integer](first, second, third Type) Type {
+	return max(min(first, second), min(max(first, second), third))
+}
+
+// Converts absolute value of an integer and its sign to an integer of specified type
+// and detects whether an overflow has occurred or not. Inverse to the [Abs] function.
+//
+// In case of overflow, an error is returned.
+func fromAbs[Type constraints.Integer](negative bool, abs uint64) (Type, error) {
+	if !negative || abs == 0 {
+		return IToI[Type](abs)
+	}
+
+	// Absolute value of the minimum value for signed types does not fit into the
+	// type, so the number is converted reduced by one in absolute value
+	interim, err := IToI[Type](abs - 1)
+	if err != nil {
+		return 0, err
+	}
+
+	negated, err := Negate(interim)
+	if err != nil {
+		return 0, err
+	}
+
+	return Sub(negated, 1)
+}
+
+// Calculates the greatest common divisor of two numbers using the Euclidean algorithm.
+//
+// The greatest common divisor of zero and zero is considered to be zero.
+func gcd(first, second uint64) uint64 {
+	for second != 0 {
+		first, second = second, first%second
+	}
+
+	return first
+}
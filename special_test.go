@@ -2,11 +2,15 @@ package safe
 
 import (
 	"math"
+	"math/big"
+	"math/bits"
+	"slices"
 	"testing"
 
 	"github.com/akramarenkov/safe/internal/iterator"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
 )
 
 func TestAbs(t *testing.T) {
@@ -77,6 +81,336 @@ func TestDistUns(t *testing.T) {
 	}
 }
 
+func TestMidpoint(t *testing.T) {
+	for first := range iterator.Iter[int8](math.MinInt8, math.MaxInt8) {
+		for second := range iterator.Iter[int8](math.MinInt8, math.MaxInt8) {
+			// Arithmetic shift rounds down
+			reference := (int(first) + int(second)) >> 1
+
+			actual := Midpoint(first, second)
+			require.Equal(t, reference, int(actual), "first: %v, second: %v", first, second)
+		}
+	}
+
+	for first := range iterator.Iter[uint8](0, math.MaxUint8) {
+		for second := range iterator.Iter[uint8](0, math.MaxUint8) {
+			reference := (int(first) + int(second)) >> 1
+
+			actual := Midpoint(first, second)
+			require.Equal(t, reference, int(actual), "first: %v, second: %v", first, second)
+		}
+	}
+
+	require.Equal(t, int64(-1), Midpoint[int64](math.MinInt64, math.MaxInt64))
+	require.Equal(t, int64(-1), Midpoint[int64](math.MaxInt64, math.MinInt64))
+	require.Equal(t, uint64(math.MaxInt64), Midpoint[uint64](0, math.MaxUint64))
+	require.Equal(t, uint64(math.MaxUint64-1), Midpoint[uint64](math.MaxUint64, math.MaxUint64-1))
+}
+
+func TestMeanOfTwo(t *testing.T) {
+	for first := range iterator.Iter[int8](math.MinInt8, math.MaxInt8) {
+		for second := range iterator.Iter[int8](math.MinInt8, math.MaxInt8) {
+			require.Equal(t, Midpoint(first, second), MeanOfTwo(first, second))
+		}
+	}
+}
+
+func TestStatistics(t *testing.T) {
+	testStatistics(t, []int8{math.MinInt8, math.MinInt8 + 1, -7, -2, -1, 0, 1, 2, 5, math.MaxInt8 - 1, math.MaxInt8})
+	testStatistics(t, []uint8{0, 1, 2, 5, 100, math.MaxUint8 - 1, math.MaxUint8})
+}
+
+func testStatistics[Type constraints.Integer](t *testing.T, dataset []Type) {
+	for length := range 5 {
+		for _, numbers := range combinations(dataset, length) {
+			original := slices.Clone(numbers)
+
+			testStatisticsNumbers(t, numbers)
+
+			require.Equal(t, original, numbers)
+		}
+	}
+}
+
+func testStatisticsNumbers[Type constraints.Integer](t *testing.T, numbers []Type) {
+	mean, err := Mean(numbers...)
+	meanRound, errRound := MeanRound(numbers...)
+	variance, errVariance := Variance(numbers...)
+	stdDev, errStdDev := StdDev(numbers...)
+	median, errMedian := Median(numbers...)
+
+	if len(numbers) == 0 {
+		require.ErrorIs(t, err, ErrMissingArguments)
+		require.ErrorIs(t, errRound, ErrMissingArguments)
+		require.ErrorIs(t, errVariance, ErrMissingArguments)
+		require.ErrorIs(t, errStdDev, ErrMissingArguments)
+		require.ErrorIs(t, errMedian, ErrMissingArguments)
+
+		return
+	}
+
+	require.NoError(t, err)
+	require.NoError(t, errRound)
+	require.NoError(t, errVariance)
+	require.NoError(t, errStdDev)
+	require.NoError(t, errMedian)
+
+	meanSeq, err := MeanSeq(slices.Values(numbers))
+	require.NoError(t, err)
+	require.Equal(t, mean, meanSeq, "numbers: %v", numbers)
+
+	count := int64(len(numbers))
+	sum := int64(0)
+	squares := int64(0)
+
+	for _, number := range numbers {
+		sum += int64(number)
+		squares += int64(number) * int64(number)
+	}
+
+	require.Equal(t, floorDiv(sum, count), int64(mean), "numbers: %v", numbers)
+	require.Equal(t, roundDiv(sum, count), int64(meanRound), "numbers: %v", numbers)
+
+	// Variance is equal to (count*squares - sum^2)/count^2
+	referenceVariance := floorDiv(count*squares-sum*sum, count*count)
+	require.Equal(t, referenceVariance, int64(variance), "numbers: %v", numbers)
+	require.Equal(t, int64(math.Sqrt(float64(referenceVariance))), int64(stdDev), "numbers: %v", numbers)
+
+	sorted := slices.Sorted(slices.Values(numbers))
+
+	referenceMedian := sorted[len(sorted)/2]
+
+	if len(sorted)%2 == 0 {
+		referenceMedian = Midpoint(sorted[len(sorted)/2-1], sorted[len(sorted)/2])
+	}
+
+	require.Equal(t, referenceMedian, median, "numbers: %v", numbers)
+
+	for percentile := range uint64(101) {
+		actual, err := Percentile(percentile, numbers...)
+		require.NoError(t, err)
+
+		// Smallest integer such that at least the specified percent of integers are
+		// not greater than it
+		rank := max((int(percentile)*len(sorted)+99)/100, 1)
+		require.Equal(t, sorted[rank-1], actual, "numbers: %v, percentile: %v", numbers, percentile)
+	}
+}
+
+func floorDiv(dividend, divisor int64) int64 {
+	quotient := dividend / divisor
+
+	if dividend%divisor != 0 && dividend < 0 {
+		quotient--
+	}
+
+	return quotient
+}
+
+func roundDiv(dividend, divisor int64) int64 {
+	quotient := dividend / divisor
+	remainder := dividend % divisor
+
+	if 2*max(remainder, -remainder) >= divisor {
+		if dividend < 0 {
+			return quotient - 1
+		}
+
+		return quotient + 1
+	}
+
+	return quotient
+}
+
+func TestStatisticsMax(t *testing.T) {
+	mean, err := Mean[int64](math.MaxInt64, math.MaxInt64, math.MaxInt64-1)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MaxInt64-1), mean)
+
+	mean, err = MeanRound[int64](math.MaxInt64, math.MaxInt64, math.MaxInt64-1)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MaxInt64), mean)
+
+	mean, err = Mean[int64](math.MinInt64, math.MinInt64, math.MinInt64+1)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MinInt64), mean)
+
+	mean, err = MeanRound[int64](math.MinInt64, math.MinInt64+1)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MinInt64), mean)
+
+	meanU, err := Mean[uint64](math.MaxUint64, math.MaxUint64, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64/3*2), meanU)
+
+	variance, err := Variance[int64](math.MinInt64, math.MaxInt64)
+	require.ErrorIs(t, err, ErrOverflow)
+	require.Zero(t, variance)
+
+	_, err = Variance[int64](-1<<32, 1<<32)
+	require.ErrorIs(t, err, ErrOverflow)
+
+	variance, err = Variance[int64](-1<<32+1, 1<<32-1)
+	require.NoError(t, err)
+	require.Equal(t, uint64((1<<32-1)*(1<<32-1)), variance)
+
+	variance, err = Variance[uint64](0, 1<<33-2)
+	require.NoError(t, err)
+	require.Equal(t, uint64((1<<32-1)*(1<<32-1)), variance)
+
+	stdDev, err := StdDev[uint64](0, 1<<33-2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<32-1), stdDev)
+
+	stdDev, err = StdDev[int64](0, 1<<33)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<32), stdDev)
+
+	stdDev, err = StdDev[int64](-1<<32, 1<<32)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<32), stdDev)
+
+	// Exact standard deviation is equal to 2^63-0.5
+	stdDev, err = StdDev[int64](math.MinInt64, math.MaxInt64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<63-1), stdDev)
+
+	stdDev, err = StdDev[uint64](0, math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<63-1), stdDev)
+
+	stdDev, err = StdDev[uint64](0, 0, math.MaxUint64, math.MaxUint64, math.MaxUint64, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<63-1), stdDev)
+
+	stdDev, err = StdDev[int64](math.MinInt64, math.MaxInt64, math.MinInt64)
+	require.NoError(t, err)
+	require.Equal(t, referenceStdDev([]int64{math.MinInt64, math.MaxInt64, math.MinInt64}), stdDev)
+
+	_, err = StdDev[int64]()
+	require.ErrorIs(t, err, ErrMissingArguments)
+
+	median, err := Median[int64](math.MinInt64, math.MaxInt64)
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), median)
+
+	_, err = Percentile[int64](101, 1)
+	require.ErrorIs(t, err, ErrPercentileRange)
+}
+
+func TestWeightedMean(t *testing.T) {
+	mean, err := WeightedMean([]int8{1, 2, 3}, []uint64{1, 1, 1})
+	require.NoError(t, err)
+	require.Equal(t, int8(2), mean)
+
+	mean, err = WeightedMean([]int8{-1, 2}, []uint64{2, 1})
+	require.NoError(t, err)
+	require.Equal(t, int8(0), mean)
+
+	mean, err = WeightedMean([]int8{-3, 2}, []uint64{2, 1})
+	require.NoError(t, err)
+	require.Equal(t, int8(-2), mean)
+
+	mean, err = WeightedMean([]int8{math.MinInt8, math.MaxInt8}, []uint64{0, 5})
+	require.NoError(t, err)
+	require.Equal(t, int8(math.MaxInt8), mean)
+
+	meanI64, err := WeightedMean([]int64{math.MaxInt64, math.MinInt64}, []uint64{math.MaxUint64 - 1, 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MaxInt64-1), meanI64)
+
+	meanU64, err := WeightedMean([]uint64{math.MaxUint64, math.MaxUint64}, []uint64{1 << 62, 1 << 62})
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64), meanU64)
+
+	_, err = WeightedMean([]uint64{math.MaxUint64, math.MaxUint64}, []uint64{1 << 63, 1 << 62})
+	require.ErrorIs(t, err, ErrOverflow)
+
+	_, err = WeightedMean([]int8{1, 2}, []uint64{math.MaxUint64, 1})
+	require.ErrorIs(t, err, ErrOverflow)
+
+	_, err = WeightedMean([]int8{1, 2}, []uint64{0, 0})
+	require.ErrorIs(t, err, ErrDivisionByZero)
+
+	_, err = WeightedMean([]int8{1, 2}, []uint64{1})
+	require.ErrorIs(t, err, ErrLengthMismatch)
+
+	_, err = WeightedMean([]int8{}, []uint64{})
+	require.ErrorIs(t, err, ErrMissingArguments)
+}
+
+func TestSqrt(t *testing.T) {
+	for number := range iterator.Iter[uint64](0, 1<<16) {
+		root := sqrt(0, number)
+		require.LessOrEqual(t, root*root, number)
+		require.Greater(t, (root+1)*(root+1), number)
+	}
+
+	require.Equal(t, uint64(math.MaxUint32), sqrt(0, math.MaxUint64))
+	require.Equal(t, uint64(math.MaxUint32-1), sqrt(0, math.MaxUint32*math.MaxUint32-1))
+	require.Equal(t, uint64(math.MaxUint32), sqrt(0, math.MaxUint32*math.MaxUint32))
+	require.Equal(t, uint64(1<<32), sqrt(1, 0))
+	require.Equal(t, uint64(math.MaxUint64), sqrt(math.MaxUint64, math.MaxUint64))
+
+	// (2^64-1)^2 = 2^128 - 2^65 + 1
+	require.Equal(t, uint64(math.MaxUint64), sqrt(math.MaxUint64-1, 1))
+	require.Equal(t, uint64(math.MaxUint64-1), sqrt(math.MaxUint64-1, 0))
+
+	for _, root := range []uint64{3, 1<<32 + 1, 1 << 63, 1<<63 + 12345, math.MaxUint64 - 2} {
+		high, low := bits.Mul64(root, root)
+		require.Equal(t, root, sqrt(high, low))
+
+		low, borrow := bits.Sub64(low, 1, 0)
+		require.Equal(t, root-1, sqrt(high-borrow, low))
+	}
+}
+
+func TestFromAbsSig(t *testing.T) {
+	for number := range iterator.Iter[int8](math.MinInt8, math.MaxInt8) {
+		converted, err := fromAbs[int8](number < 0, Abs(number))
+		require.NoError(t, err, "number: %v", number)
+		require.Equal(t, number, converted, "number: %v", number)
+	}
+
+	_, err := fromAbs[int8](true, -math.MinInt8+1)
+	require.Error(t, err)
+
+	_, err = fromAbs[int8](false, math.MaxInt8+1)
+	require.Error(t, err)
+
+	_, err = fromAbs[int64](true, math.MaxUint64)
+	require.Error(t, err)
+}
+
+func TestFromAbsUns(t *testing.T) {
+	for number := range iterator.Iter[uint8](0, math.MaxUint8) {
+		converted, err := fromAbs[uint8](false, Abs(number))
+		require.NoError(t, err, "number: %v", number)
+		require.Equal(t, number, converted, "number: %v", number)
+	}
+
+	converted, err := fromAbs[uint8](true, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint8(0), converted)
+
+	_, err = fromAbs[uint8](true, 1)
+	require.Error(t, err)
+
+	_, err = fromAbs[uint8](false, math.MaxUint8+1)
+	require.Error(t, err)
+}
+
+func TestGCD(t *testing.T) {
+	require.Equal(t, uint64(0), gcd(0, 0))
+	require.Equal(t, uint64(5), gcd(0, 5))
+	require.Equal(t, uint64(5), gcd(5, 0))
+	require.Equal(t, uint64(6), gcd(12, 18))
+	require.Equal(t, uint64(6), gcd(18, 12))
+	require.Equal(t, uint64(1), gcd(17, 13))
+	require.Equal(t, uint64(1), gcd(math.MaxUint64, math.MaxUint64-1))
+	require.Equal(t, uint64(1<<63), gcd(1<<63, 0))
+}
+
 func BenchmarkDistReference(b *testing.B) {
 	dist := 0
 
@@ -96,3 +430,66 @@ func BenchmarkDist(b *testing.B) {
 
 	require.NotNil(b, dist)
 }
+
+func BenchmarkMidpoint(b *testing.B) {
+	number := int64(0)
+
+	for id := range b.N {
+		number = Midpoint(int64(id), math.MaxInt64)
+	}
+
+	require.NotZero(b, number)
+}
+
+func BenchmarkMean(b *testing.B) {
+	numbers := slices.Collect(Inc[int64](math.MaxInt64-1000, math.MaxInt64))
+	mean := int64(0)
+
+	for range b.N {
+		mean, _ = Mean(numbers...)
+	}
+
+	require.NotZero(b, mean)
+}
+
+func BenchmarkVariance(b *testing.B) {
+	numbers := slices.Collect(Inc[int64](math.MaxInt64-1000, math.MaxInt64))
+	variance := uint64(0)
+
+	for range b.N {
+		variance, _ = Variance(numbers...)
+	}
+
+	require.NotZero(b, variance)
+}
+
+func BenchmarkMedian(b *testing.B) {
+	numbers := slices.Collect(Shuffled[int64](0, 1000, 1))
+	median := int64(0)
+
+	for range b.N {
+		median, _ = Median(numbers...)
+	}
+
+	require.NotZero(b, median)
+}
+
+func referenceStdDev(numbers []int64) uint64 {
+	count := big.NewInt(int64(len(numbers)))
+	sum := new(big.Int)
+	squares := new(big.Int)
+
+	for _, number := range numbers {
+		value := big.NewInt(number)
+
+		sum.Add(sum, value)
+		squares.Add(squares, new(big.Int).Mul(value, value))
+	}
+
+	// Variance multiplied by count^2 is equal to squares*count - sum^2, so the
+	// standard deviation is equal to sqrt(squares*count - sum^2)/count
+	scaled := new(big.Int).Mul(squares, count)
+	scaled.Sub(scaled, new(big.Int).Mul(sum, sum))
+
+	return new(big.Int).Quo(new(big.Int).Sqrt(scaled), count).Uint64()
+}
@@ -0,0 +1,132 @@
+package safe
+
+import (
+	"iter"
+	"math/bits"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Splits the range of integer values from begin to end inclusive into the specified
+// number of contiguous, non-overlapping sub-ranges that cover it exactly. Intended
+// for distributing work over goroutines.
+//
+// Sizes of sub-ranges differ by no more than one, larger sub-ranges come first.
+//
+// If begin is greater than end, the sub-ranges are decremented, otherwise they are
+// incremented.
+//
+// If the number of parts exceeds the number of integer values in the range, then
+// sub-ranges contain one value each and their number is equal to the number of
+// values. If the number of parts is zero, then no one sub-range is returned.
+func Split[Type constraints.Integer](begin, end Type, parts uint64) iter.Seq[Range[Type]] {
+	iterator := func(yield func(Range[Type]) bool) {
+		if parts == 0 {
+			return
+		}
+
+		distance := Dist(begin, end)
+
+		if parts == 1 {
+			yield(Range[Type]{Begin: begin, End: end})
+			return
+		}
+
+		// Number of values in the range is equal to the distance plus one and may
+		// not fit into uint64, so it is represented as a 128-bit number. The high
+		// part is not greater than one and is less than the number of parts, so
+		// the division does not panic
+		low, high := bits.Add64(distance, 1, 0)
+		quotient, remainder := bits.Div64(high, low, parts)
+
+		if quotient == 0 {
+			parts = remainder
+		}
+
+		forward := begin <= end
+		first := begin
+
+		for part := range parts {
+			// Size of the sub-range minus one is not greater than the distance
+			last := quotient - 1
+
+			if part < remainder {
+				last = quotient
+			}
+
+			if !yield(Range[Type]{Begin: first, End: shift(first, last, forward)}) {
+				return
+			}
+
+			// Next value is calculated only for non-last sub-ranges so it lies
+			// between begin and end
+			if part != parts-1 {
+				first = shift(first, last+1, forward)
+			}
+		}
+	}
+
+	return iterator
+}
+
+// Splits the range of integer values from begin to end inclusive into contiguous,
+// non-overlapping sub-ranges of the specified size that cover it exactly. The last
+// sub-range may be smaller than the specified size.
+//
+// If begin is greater than end, the sub-ranges are decremented, otherwise they are
+// incremented.
+//
+// If a zero or negative size is specified, then no one sub-range is returned.
+func Chunks[Type constraints.Integer](begin, end, size Type) iter.Seq[Range[Type]] {
+	iterator := func(yield func(Range[Type]) bool) {
+		if size <= 0 {
+			return
+		}
+
+		forward := begin <= end
+		remaining := Dist(begin, end)
+		first := begin
+
+		// Size is positive, so the size minus one fits into uint64
+		last := uint64(size) - 1
+
+		for remaining > last {
+			if !yield(Range[Type]{Begin: first, End: shift(first, last, forward)}) {
+				return
+			}
+
+			remaining -= last + 1
+			first = shift(first, last+1, forward)
+		}
+
+		yield(Range[Type]{Begin: first, End: end})
+	}
+
+	return iterator
+}
+
+// Calculates the number of sub-ranges when using [Chunks]. The return value is
+// intended to be used as the size parameter in the make call, so, and because the
+// maximum possible number of sub-ranges is one more than the maximum value for
+// uint64, the return value is truncated to the maximum value for uint64 if the
+// calculated value exceeds it.
+//
+// If a zero or negative size is specified, then zero is returned.
+func ChunksSize[Type constraints.Integer](begin, end, size Type) uint64 {
+	if size <= 0 {
+		return 0
+	}
+
+	return stepSize(begin, end, uint64(size))
+}
+
+// Shifts a number by the specified offset in specified direction. The result must
+// lie between the number and the end of the range, so the addition or subtraction
+// with wrapping around is correct even if the offset does not fit into the type.
+func shift[Type constraints.Integer](number Type, offset uint64, forward bool) Type {
+	if forward {
+		return number + Type(offset)
+	}
+
+	return number - Type(offset)
+}
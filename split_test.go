@@ -0,0 +1,207 @@
+package safe
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/constraints"
+)
+
+func TestSplit(t *testing.T) {
+	bounds := []int8{math.MinInt8, math.MinInt8 + 1, -3, 0, 5, math.MaxInt8 - 1, math.MaxInt8}
+	boundsU := []uint8{0, 1, 5, math.MaxUint8 - 1, math.MaxUint8}
+
+	for parts := range Iter[uint64](0, math.MaxUint8+2) {
+		for _, begin := range bounds {
+			for _, end := range bounds {
+				testSplit(t, begin, end, parts)
+			}
+		}
+
+		for _, begin := range boundsU {
+			for _, end := range boundsU {
+				testSplit(t, begin, end, parts)
+			}
+		}
+	}
+}
+
+func testSplit[Type constraints.Integer](t *testing.T, begin, end Type, parts uint64) {
+	expected := slices.Collect(Iter(begin, end))
+
+	actual := []Type(nil)
+	sizes := []uint64(nil)
+
+	for rng := range Split(begin, end, parts) {
+		require.False(t, rng.Exclusive)
+		require.Zero(t, rng.Step)
+
+		actual = append(actual, slices.Collect(Iter(rng.Begin, rng.End))...)
+		sizes = append(sizes, rng.Len())
+	}
+
+	if parts == 0 {
+		require.Empty(t, sizes)
+		return
+	}
+
+	require.Equal(t, expected, actual, "begin: %v, end: %v, parts: %v", begin, end, parts)
+	require.Len(t, sizes, int(min(parts, uint64(len(expected)))))
+	require.True(t, slices.IsSortedFunc(sizes, func(first, second uint64) int {
+		return int(second) - int(first)
+	}))
+	require.LessOrEqual(t, slices.Max(sizes)-slices.Min(sizes), uint64(1))
+}
+
+func TestSplitMax(t *testing.T) {
+	ranges := slices.Collect(Split[uint64](0, math.MaxUint64, 3))
+	require.Equal(
+		t,
+		[]Range[uint64]{
+			{Begin: 0, End: 6148914691236517205},
+			{Begin: 6148914691236517206, End: 12297829382473034410},
+			{Begin: 12297829382473034411, End: math.MaxUint64},
+		},
+		ranges,
+	)
+
+	ranges = slices.Collect(Split[uint64](math.MaxUint64, 0, 2))
+	require.Equal(
+		t,
+		[]Range[uint64]{
+			{Begin: math.MaxUint64, End: math.MaxInt64 + 1},
+			{Begin: math.MaxInt64, End: 0},
+		},
+		ranges,
+	)
+
+	sranges := []Range[int64](nil)
+
+	for rng := range Split[int64](math.MinInt64, math.MaxInt64, math.MaxUint64) {
+		if len(sranges) == 2 {
+			break
+		}
+
+		sranges = append(sranges, rng)
+	}
+
+	require.Equal(
+		t,
+		[]Range[int64]{
+			{Begin: math.MinInt64, End: math.MinInt64 + 1},
+			{Begin: math.MinInt64 + 2, End: math.MinInt64 + 2},
+		},
+		sranges,
+	)
+}
+
+func TestSplitPart(t *testing.T) {
+	ranges := []Range[int8](nil)
+
+	for rng := range Split[int8](0, 9, 5) {
+		if rng.Begin > 4 {
+			break
+		}
+
+		ranges = append(ranges, rng)
+	}
+
+	require.Equal(t, []Range[int8]{{Begin: 0, End: 1}, {Begin: 2, End: 3}, {Begin: 4, End: 5}}, ranges)
+}
+
+func TestChunks(t *testing.T) {
+	bounds := []int8{math.MinInt8, math.MinInt8 + 1, -3, 0, 5, math.MaxInt8 - 1, math.MaxInt8}
+	boundsU := []uint8{0, 1, 5, math.MaxUint8 - 1, math.MaxUint8}
+
+	for size := range Iter[int8](math.MinInt8, math.MaxInt8) {
+		for _, begin := range bounds {
+			for _, end := range bounds {
+				testChunks(t, begin, end, size)
+			}
+		}
+	}
+
+	for size := range Iter[uint8](0, math.MaxUint8) {
+		for _, begin := range boundsU {
+			for _, end := range boundsU {
+				testChunks(t, begin, end, size)
+			}
+		}
+	}
+}
+
+func testChunks[Type constraints.Integer](t *testing.T, begin, end, size Type) {
+	expected := slices.Collect(Iter(begin, end))
+
+	actual := []Type(nil)
+	sizes := []uint64(nil)
+
+	for rng := range Chunks(begin, end, size) {
+		actual = append(actual, slices.Collect(Iter(rng.Begin, rng.End))...)
+		sizes = append(sizes, rng.Len())
+	}
+
+	require.Equal(t, uint64(len(sizes)), ChunksSize(begin, end, size))
+
+	if size <= 0 {
+		require.Empty(t, sizes)
+		return
+	}
+
+	require.Equal(t, expected, actual, "begin: %v, end: %v, size: %v", begin, end, size)
+
+	for _, chunk := range sizes[:len(sizes)-1] {
+		require.Equal(t, uint64(size), chunk, "begin: %v, end: %v, size: %v", begin, end, size)
+	}
+
+	require.LessOrEqual(t, sizes[len(sizes)-1], uint64(size))
+}
+
+func TestChunksMax(t *testing.T) {
+	ranges := slices.Collect(Chunks[uint64](0, math.MaxUint64, math.MaxInt64+1))
+	require.Equal(
+		t,
+		[]Range[uint64]{
+			{Begin: 0, End: math.MaxInt64},
+			{Begin: math.MaxInt64 + 1, End: math.MaxUint64},
+		},
+		ranges,
+	)
+
+	sranges := slices.Collect(Chunks[int64](math.MaxInt64, math.MinInt64, math.MaxInt64))
+	require.Equal(
+		t,
+		[]Range[int64]{
+			{Begin: math.MaxInt64, End: 1},
+			{Begin: 0, End: math.MinInt64 + 2},
+			{Begin: math.MinInt64 + 1, End: math.MinInt64},
+		},
+		sranges,
+	)
+
+	require.Equal(t, uint64(math.MaxUint64), ChunksSize[uint64](0, math.MaxUint64, 1))
+	require.Equal(t, uint64(2), ChunksSize[uint64](0, math.MaxUint64, math.MaxInt64+1))
+	require.Equal(t, uint64(3), ChunksSize[int64](math.MaxInt64, math.MinInt64, math.MaxInt64))
+}
+
+func BenchmarkSplit(b *testing.B) {
+	number := uint64(0)
+
+	for rng := range Split[uint64](0, math.MaxUint64, uint64(b.N)) {
+		number = rng.End
+	}
+
+	require.NotZero(b, number)
+}
+
+func BenchmarkChunks(b *testing.B) {
+	number := 0
+
+	for rng := range Chunks(1, b.N, 1) {
+		number = rng.End
+	}
+
+	require.NotZero(b, number)
+}